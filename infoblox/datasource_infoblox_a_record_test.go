@@ -26,6 +26,9 @@ func TestAccDataSourceARecord(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "zone", "a.com"),
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "fqdn", "test-name.a.com"),
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "ip_addr", "10.0.0.2"),
+					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "comment", "web frontend"),
+					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "disable", "false"),
 					testARecordEAs(t, "data.infoblox_a_record.acctest", "eas", expected_eas),
 				),
 				ExpectNonEmptyPlan: true,
@@ -59,6 +62,8 @@ resource "infoblox_a_record" "foo"{
 	zone="a.com"
 	cidr="10.0.0.0/24"
 	ip_addr="10.0.0.2"
+	ttl=300
+	comment="web frontend"
 	tenant_id="foo"
 }
 
@@ -0,0 +1,49 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAAAARecord(t *testing.T) {
+	expected_eas := map[string]string{
+		"CMP Type":        "Terraform",
+		"Cloud API Owned": "true",
+		"Tenant ID":       "foo",
+		"VM Name":         "test-name",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAAAARecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_aaaa_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_aaaa_record.acctest", "zone", "a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_aaaa_record.acctest", "fqdn", "test-name.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_aaaa_record.acctest", "ip_addr", "2001:db8::2"),
+					testARecordEAs(t, "data.infoblox_aaaa_record.acctest", "eas", expected_eas),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAAAARecordsRead = fmt.Sprintf(`
+resource "infoblox_aaaa_record" "foo"{
+	vm_name="test-name"
+	dns_view="default"
+	zone="a.com"
+	ip_addr="2001:db8::2"
+	tenant_id="foo"
+}
+
+data "infoblox_aaaa_record" "acctest" {
+	fqdn="${infoblox_aaaa_record.foo.vm_name}.a.com"
+	zone="a.com"
+	ip_addr=infoblox_aaaa_record.foo.ip_addr
+}
+`)
@@ -40,6 +40,21 @@ func dataSourceCNameRecord() *schema.Resource {
 				Computed:    true,
 				Description: "Canonical name.",
 			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL of the record in seconds, 0 when the zone TTL is used.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comment of the record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the record is disabled.",
+			},
 			"eas": &schema.Schema{
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -88,6 +103,9 @@ func dataSourceCNameRecordRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("zone", records[0].Zone)
 	d.Set("dns_view", records[0].View)
 	d.Set("fqdn", records[0].Name)
+	d.Set("ttl", ttlForState(records[0].Ttl, records[0].UseTtl))
+	d.Set("comment", stringValue(records[0].Comment))
+	d.Set("disable", boolValue(records[0].Disable))
 
 	eas := make(map[string]string)
 	for key, value := range records[0].Ea {
@@ -0,0 +1,87 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSViewRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the DNS view.",
+			},
+			"network_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network view the DNS view is associated with.",
+			},
+			"recursion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether recursive queries are answered in the DNS view.",
+			},
+			"match_clients": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "Clients whose queries are answered from the DNS view.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A descriptive comment for the DNS view.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extensible attributes of the DNS view.",
+			},
+		},
+	}
+}
+
+func dataSourceDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(*ibclient.Connector)
+
+	name := d.Get("name").(string)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	obj, err := objMgr.GetView(name)
+	if err != nil {
+		return fmt.Errorf("Getting DNS view (%s) failed : %s", name, err)
+	}
+	if obj == nil {
+		return fmt.Errorf("No DNS view found. name(%s)", name)
+	}
+
+	d.Set("network_view", obj.NetworkView)
+	d.Set("recursion", boolValue(obj.Recursion))
+	if err := d.Set("match_clients", addressACsForState(obj.MatchClients)); err != nil {
+		return err
+	}
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	d.SetId(obj.Ref)
+
+	return nil
+}
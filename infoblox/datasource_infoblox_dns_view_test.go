@@ -0,0 +1,41 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceDNSView(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceDNSViewRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "network_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "match_clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "comment", "acctest-view"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceDNSViewRead = fmt.Sprintf(`
+resource "infoblox_dns_view" "test_view"{
+  name      = "acctest-internal"
+  comment   = "acctest-view"
+  tenant_id = "test_tenant_id"
+  match_clients {
+    address = "10.0.0.0/8"
+  }
+}
+
+data "infoblox_dns_view" "acctest" {
+  name = infoblox_dns_view.test_view.name
+}
+`)
@@ -0,0 +1,84 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceIpv6FixedAddress() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpv6FixedAddressRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Network view name available in NIOS Server.",
+			},
+			"ip_addr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IPv6 address of the fixed address.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IPv6 network of the fixed address in cidr format.",
+			},
+			"duid": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DHCPv6 unique identifier of the client.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the IPv6 fixed address.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A descriptive comment for the IPv6 fixed address.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extensible attributes of the IPv6 fixed address.",
+			},
+		},
+	}
+}
+
+func dataSourceIpv6FixedAddressRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(*ibclient.Connector)
+
+	ipAddr := d.Get("ip_addr").(string)
+	networkViewName := d.Get("network_view_name").(string)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	obj, err := objMgr.GetIpv6FixedAddress(networkViewName, ipAddr)
+	if err != nil {
+		return fmt.Errorf("Getting IPv6 fixed address (%s) failed : %s", ipAddr, err)
+	}
+	if obj == nil {
+		return fmt.Errorf("No IPv6 fixed address found. network view(%s) ip_addr(%s)", networkViewName, ipAddr)
+	}
+
+	d.Set("cidr", obj.Cidr)
+	d.Set("duid", obj.Duid)
+	if obj.Name != nil {
+		d.Set("name", *obj.Name)
+	}
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	d.SetId(obj.Ref)
+
+	return nil
+}
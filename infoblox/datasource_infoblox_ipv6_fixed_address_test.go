@@ -0,0 +1,42 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceIpv6FixedAddress(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceIpv6FixedAddressRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.acctest", "duid", "00:01:00:01:2a:3b:4c:7d"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.acctest", "cidr", "2001:db8:70::/64"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceIpv6FixedAddressRead = fmt.Sprintf(`
+resource "infoblox_ipv6_network" "test_network"{
+  cidr      = "2001:db8:70::/64"
+  tenant_id = "test_tenant_id"
+}
+
+resource "infoblox_ipv6_fixed_address" "test_address"{
+  cidr      = infoblox_ipv6_network.test_network.cidr
+  duid      = "00:01:00:01:2a:3b:4c:7d"
+  tenant_id = "test_tenant_id"
+}
+
+data "infoblox_ipv6_fixed_address" "acctest" {
+  ip_addr = infoblox_ipv6_fixed_address.test_address.ip_addr
+}
+`)
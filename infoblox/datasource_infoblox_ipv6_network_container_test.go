@@ -0,0 +1,36 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceIpv6NetworkContainer(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceIpv6NetworkContainerRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_network_container.acctest", "comment", "acctest-container"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceIpv6NetworkContainerRead = fmt.Sprintf(`
+resource "infoblox_ipv6_network_container" "test_container"{
+  cidr      = "2001:db8:60::/48"
+  comment   = "acctest-container"
+  tenant_id = "test_tenant_id"
+}
+
+data "infoblox_ipv6_network_container" "acctest" {
+  cidr = infoblox_ipv6_network_container.test_container.cidr
+}
+`)
@@ -0,0 +1,36 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceIpv6Network(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceIpv6NetworkRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_network.acctest", "comment", "acctest-network"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceIpv6NetworkRead = fmt.Sprintf(`
+resource "infoblox_ipv6_network" "test_network"{
+  cidr      = "2001:db8:50::/64"
+  comment   = "acctest-network"
+  tenant_id = "test_tenant_id"
+}
+
+data "infoblox_ipv6_network" "acctest" {
+  cidr = infoblox_ipv6_network.test_network.cidr
+}
+`)
@@ -0,0 +1,119 @@
+package infoblox
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMXRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Fully qualified domain name the mail exchangers serve.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Zone under which records have been created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"mail_exchangers": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Mail exchangers of the name ordered by preference.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mail_exchanger": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preference": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ttl": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"comment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disable": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMXRecordRead(d *schema.ResourceData, m interface{}) error {
+	var records []ibclient.RecordMX
+
+	zone := d.Get("zone").(string)
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	connector := m.(*ibclient.Connector)
+
+	search_data := ibclient.NewRecordMX(
+		ibclient.RecordMX{
+			Name: fqdn,
+			Zone: zone,
+			View: dnsView,
+		})
+	err := connector.GetObject(search_data, "", &records)
+	d.SetId("")
+	if err != nil {
+		return fmt.Errorf("Read MX record failed: %s", err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("No MX record found. view(%s) zone(%s) fqdn(%s)", dnsView, zone, fqdn)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		if uintValue(records[i].Preference) != uintValue(records[j].Preference) {
+			return uintValue(records[i].Preference) < uintValue(records[j].Preference)
+		}
+		return records[i].MailExchanger < records[j].MailExchanger
+	})
+
+	exchangers := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		exchanger := map[string]interface{}{
+			"mail_exchanger": record.MailExchanger,
+			"preference":     int(uintValue(record.Preference)),
+			"ttl":            ttlForState(record.Ttl, record.UseTtl),
+			"comment":        stringValue(record.Comment),
+			"disable":        boolValue(record.Disable),
+		}
+		exchangers = append(exchangers, exchanger)
+	}
+	d.Set("mail_exchangers", exchangers)
+	d.Set("zone", records[0].Zone)
+	d.Set("dns_view", records[0].View)
+
+	d.SetId(fmt.Sprintf("%s/%s", records[0].View, records[0].Name))
+
+	return nil
+}
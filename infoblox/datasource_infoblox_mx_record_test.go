@@ -0,0 +1,50 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMXRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceMXRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "zone", "a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "mail_exchangers.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "mail_exchangers.0.mail_exchanger", "mx1.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "mail_exchangers.0.preference", "10"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "mail_exchangers.1.mail_exchanger", "mx2.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_mx_record.acctest", "mail_exchangers.1.preference", "20"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceMXRecordsRead = fmt.Sprintf(`
+resource "infoblox_mx_record" "backup"{
+	fqdn="a.com"
+	mail_exchanger="mx2.a.com"
+	preference=20
+	tenant_id="foo"
+}
+
+resource "infoblox_mx_record" "primary"{
+	fqdn="a.com"
+	mail_exchanger="mx1.a.com"
+	preference=10
+	tenant_id="foo"
+}
+
+data "infoblox_mx_record" "acctest" {
+	fqdn="a.com"
+	depends_on=[infoblox_mx_record.backup, infoblox_mx_record.primary]
+}
+`)
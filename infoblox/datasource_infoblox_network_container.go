@@ -0,0 +1,64 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceNetworkContainer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkContainerRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The network container in cidr format.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A descriptive comment for the network container.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extensible attributes of the network container.",
+			},
+		},
+	}
+}
+
+func dataSourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(*ibclient.Connector)
+
+	cidr := d.Get("cidr").(string)
+	networkViewName := d.Get("network_view_name").(string)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	obj, err := objMgr.GetNetworkContainer(networkViewName, cidr)
+	if err != nil {
+		return fmt.Errorf("Getting network container (%s) failed : %s", cidr, err)
+	}
+	if obj == nil {
+		return fmt.Errorf("No network container found. network view(%s) cidr(%s)", networkViewName, cidr)
+	}
+
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	d.SetId(obj.Ref)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNetworkContainer(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceNetworkContainerRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_network_container.acctest", "comment", "acctest-container"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNetworkContainerRead = fmt.Sprintf(`
+resource "infoblox_network_container" "test_container"{
+  cidr      = "10.60.0.0/16"
+  comment   = "acctest-container"
+  tenant_id = "test_tenant_id"
+}
+
+data "infoblox_network_container" "acctest" {
+  cidr = infoblox_network_container.test_container.cidr
+}
+`)
@@ -0,0 +1,132 @@
+package infoblox
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceSRVRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSRVRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service name of the SRV records, e.g. _ldap._tcp.example.com.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Zone under which records have been created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Targets of the service ordered by priority and weight.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ttl": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"comment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disable": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSRVRecordRead(d *schema.ResourceData, m interface{}) error {
+	var records []ibclient.RecordSRV
+
+	zone := d.Get("zone").(string)
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	connector := m.(*ibclient.Connector)
+
+	search_data := ibclient.NewRecordSRV(
+		ibclient.RecordSRV{
+			Name: fqdn,
+			Zone: zone,
+			View: dnsView,
+		})
+	err := connector.GetObject(search_data, "", &records)
+	d.SetId("")
+	if err != nil {
+		return fmt.Errorf("Read SRV record failed: %s", err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("No SRV record found. view(%s) zone(%s) fqdn(%s)", dnsView, zone, fqdn)
+	}
+
+	targets := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		target := map[string]interface{}{
+			"target":   record.Target,
+			"port":     int(uintValue(record.Port)),
+			"priority": int(uintValue(record.Priority)),
+			"weight":   int(uintValue(record.Weight)),
+			"ttl":      ttlForState(record.Ttl, record.UseTtl),
+			"comment":  stringValue(record.Comment),
+			"disable":  boolValue(record.Disable),
+		}
+		targets = append(targets, target)
+	}
+	// Lower priorities are tried first, higher weights are picked more often.
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i]["priority"] != targets[j]["priority"] {
+			return targets[i]["priority"].(int) < targets[j]["priority"].(int)
+		}
+		if targets[i]["weight"] != targets[j]["weight"] {
+			return targets[i]["weight"].(int) > targets[j]["weight"].(int)
+		}
+		return targets[i]["target"].(string) < targets[j]["target"].(string)
+	})
+	d.Set("targets", targets)
+	d.Set("zone", records[0].Zone)
+	d.Set("dns_view", records[0].View)
+
+	d.SetId(fmt.Sprintf("%s/%s", records[0].View, records[0].Name))
+
+	return nil
+}
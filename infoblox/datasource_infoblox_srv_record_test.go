@@ -0,0 +1,54 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceSRVRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceSRVRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "zone", "a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "targets.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "targets.0.target", "sip1.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "targets.0.port", "5060"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "targets.1.target", "sip2.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_srv_record.acctest", "targets.1.priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceSRVRecordsRead = fmt.Sprintf(`
+resource "infoblox_srv_record" "backup"{
+	fqdn="_sip._udp.a.com"
+	priority=20
+	weight=0
+	port=5060
+	target="sip2.a.com"
+	tenant_id="foo"
+}
+
+resource "infoblox_srv_record" "primary"{
+	fqdn="_sip._udp.a.com"
+	priority=10
+	weight=0
+	port=5060
+	target="sip1.a.com"
+	tenant_id="foo"
+}
+
+data "infoblox_srv_record" "acctest" {
+	fqdn="_sip._udp.a.com"
+	depends_on=[infoblox_srv_record.backup, infoblox_srv_record.primary]
+}
+`)
@@ -0,0 +1,116 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func dataSourceTXTRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTXTRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Zone under which record has been created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "TXT record FQDN.",
+			},
+			"text": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Text of the TXT record.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL of the record in seconds, 0 when the zone TTL is used.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comment of the record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the record is disabled.",
+			},
+			"eas": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Extension attributes",
+			},
+			"first_record": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Return first found record. Raise error if set to false and more than one record found.",
+			},
+		},
+	}
+}
+
+func dataSourceTXTRecordRead(d *schema.ResourceData, m interface{}) error {
+	var records []ibclient.RecordTXT
+
+	zone := d.Get("zone").(string)
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+	first_record := d.Get("first_record").(bool)
+
+	connector := m.(*ibclient.Connector)
+
+	search_data := ibclient.NewRecordTXT(
+		ibclient.RecordTXT{
+			Name: fqdn,
+			Zone: zone,
+			View: dnsView,
+		})
+	err := connector.GetObject(search_data, "", &records)
+	d.SetId("")
+	if err != nil {
+		return fmt.Errorf("Read TXT record failed: %s", err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("No TXT record found. view(%s) zone(%s) fqdn(%s)", dnsView, zone, fqdn)
+	}
+	if len(records) > 1 && !first_record {
+		return fmt.Errorf("Expect single record but found %d TXT records. view(%s) zone(%s) fqdn(%s)", len(records), dnsView, zone, fqdn)
+	}
+	d.Set("text", records[0].Text)
+	d.Set("zone", records[0].Zone)
+	d.Set("dns_view", records[0].View)
+	d.Set("fqdn", records[0].Name)
+	d.Set("ttl", ttlForState(records[0].Ttl, records[0].UseTtl))
+	d.Set("comment", stringValue(records[0].Comment))
+	d.Set("disable", boolValue(records[0].Disable))
+
+	eas := make(map[string]string)
+	for key, value := range records[0].Ea {
+		eas[key] = fmt.Sprintf("%v", value)
+	}
+	d.Set("eas", eas)
+
+	d.SetId(records[0].Ref)
+
+	return nil
+}
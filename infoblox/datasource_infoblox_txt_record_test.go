@@ -0,0 +1,49 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTXTRecord(t *testing.T) {
+	expected_eas := map[string]string{
+		"CMP Type":        "Terraform",
+		"Cloud API Owned": "true",
+		"Tenant ID":       "foo",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceTXTRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "zone", "a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "fqdn", "txt.a.com"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "text", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "ttl", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.acctest", "disable", "true"),
+					testARecordEAs(t, "data.infoblox_txt_record.acctest", "eas", expected_eas),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceTXTRecordsRead = fmt.Sprintf(`
+resource "infoblox_txt_record" "foo"{
+	fqdn="txt.a.com"
+	text="v=spf1 mx -all"
+	dns_view="default"
+	disable=true
+	tenant_id="foo"
+}
+
+data "infoblox_txt_record" "acctest" {
+	fqdn=infoblox_txt_record.foo.fqdn
+	zone="a.com"
+}
+`)
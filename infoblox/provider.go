@@ -70,11 +70,42 @@ func Provider() terraform.ResourceProvider {
 			"infoblox_a_record":       resourceARecord(),
 			"infoblox_cname_record":   resourceCNAMERecord(),
 			"infoblox_ptr_record":     resourcePTRRecord(),
+			"infoblox_host_record":    resourceHostRecord(),
+			"infoblox_aaaa_record":    resourceAAAARecord(),
+			"infoblox_zone_auth":      resourceZoneAuth(),
+
+			"infoblox_network_container":      resourceNetworkContainer(),
+			"infoblox_ipv6_network":           resourceIpv6Network(),
+			"infoblox_ipv6_network_container": resourceIpv6NetworkContainer(),
+			"infoblox_ipv6_fixed_address":     resourceIpv6FixedAddress(),
+			"infoblox_txt_record":             resourceTXTRecord(),
+			"infoblox_mx_record":              resourceMXRecord(),
+			"infoblox_srv_record":             resourceSRVRecord(),
+			"infoblox_zone_delegated":         resourceZoneDelegated(),
+			"infoblox_ns_record":              resourceNSRecord(),
+			"infoblox_caa_record":             resourceCAARecord(),
+			"infoblox_tlsa_record":            resourceTLSARecord(),
+			"infoblox_unknown_record":         resourceUnknownRecord(),
+			"infoblox_dns_view":               resourceDNSView(),
+			"infoblox_zone_forward":           resourceZoneForward(),
+			"infoblox_dhcp_range":             resourceDHCPRange(),
+			"infoblox_dhcp_reservation":       resourceDHCPReservation(),
+			"infoblox_shared_network":         resourceSharedNetwork(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"infoblox_network":      dataSourceNetwork(),
 			"infoblox_a_record":     dataSourceARecord(),
 			"infoblox_cname_record": dataSourceCNameRecord(),
+			"infoblox_aaaa_record":  dataSourceAAAARecord(),
+
+			"infoblox_network_container":      dataSourceNetworkContainer(),
+			"infoblox_ipv6_network":           dataSourceIpv6Network(),
+			"infoblox_ipv6_network_container": dataSourceIpv6NetworkContainer(),
+			"infoblox_ipv6_fixed_address":     dataSourceIpv6FixedAddress(),
+			"infoblox_txt_record":             dataSourceTXTRecord(),
+			"infoblox_mx_record":              dataSourceMXRecord(),
+			"infoblox_srv_record":             dataSourceSRVRecord(),
+			"infoblox_dns_view":               dataSourceDNSView(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -1,8 +1,13 @@
 package infoblox
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
@@ -79,3 +84,56 @@ func runTestCases(t *testing.T, cases []testCase) {
 		}
 	}
 }
+
+// testRequest is a WAPI request sent through a testRequestor.
+type testRequest struct {
+	method string
+	url    string
+	body   string
+}
+
+// object returns the WAPI object sent in the body of the request.
+func (r testRequest) object(t *testing.T) map[string]interface{} {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(r.body), &obj); err != nil {
+		t.Fatalf("%s %s sent an invalid body %q: %s", r.method, r.url, r.body, err)
+	}
+	return obj
+}
+
+// testRequestor answers WAPI requests with canned responses, in order, and
+// records the requests, so that the mapping of a resource to WAPI objects can
+// be tested without a grid.
+type testRequestor struct {
+	responses []string
+	requests  []testRequest
+}
+
+func (r *testRequestor) Init(ibclient.TransportConfig) {}
+
+func (r *testRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.requests = append(r.requests, testRequest{req.Method, req.URL.String(), string(body)})
+	if len(r.responses) == 0 {
+		return nil, fmt.Errorf("unexpected request %s %s", req.Method, req.URL)
+	}
+	res := r.responses[0]
+	r.responses = r.responses[1:]
+	return []byte(res), nil
+}
+
+// testConnector returns a connector sending its requests to a testRequestor
+// answering with responses.
+func testConnector(responses ...string) (*ibclient.Connector, *testRequestor) {
+	requestor := &testRequestor{responses: responses}
+	connector := &ibclient.Connector{
+		RequestBuilder: &ibclient.WapiRequestBuilder{
+			HostConfig: ibclient.HostConfig{Host: "nios.example.com", Version: "2.7", Port: "443"},
+		},
+		Requestor: requestor,
+	}
+	return connector, requestor
+}
@@ -14,12 +14,22 @@ func resourceARecord() *schema.Resource {
 		Read:   resourceARecordGet,
 		Update: resourceARecordUpdate,
 		Delete: resourceARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceARecordImport,
+		},
+		CustomizeDiff: recordNameCustomizeDiff("vm_name"),
 
 		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vm_name", "zone"},
+				Description:   "Fully qualified domain name of the A record. Alternative to vm_name and zone.",
+			},
 			"vm_name": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the VM.",
+				Optional:    true,
+				Description: "The name of the VM, used as the record name in zone. Also stored in the VM Name extensible attribute.",
 			},
 			"cidr": &schema.Schema{
 				Type:        schema.TypeString,
@@ -28,25 +38,56 @@ func resourceARecord() *schema.Resource {
 			},
 			"zone": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Zone under which record has to be created.",
 			},
 			"dns_view": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "default",
+				ForceNew:    true,
 				Description: "Dns View under which the zone has been created.",
 			},
 			"ip_addr": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "IP address your instance in cloud. For static allocation, set the field with valid IP. For dynamic allocation, leave this field empty and set the cidr field.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "IP address your instance in cloud. For static allocation, set the field with valid IP. For dynamic allocation, leave this field empty and set the cidr field.",
+				DiffSuppressFunc: suppressDynamicIPDiff,
 			},
 			"vm_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "instance id.",
 			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the A record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the A record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the A record without deleting it.",
+			},
+			"create_ptr": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage a PTR record for the address of the A record, created, updated and deleted together with it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the A record.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,24 +97,59 @@ func resourceARecord() *schema.Resource {
 	}
 }
 
+// ptrRecordForA returns the PTR record kept in sync with the A record ra
+// when create_ptr is set. Its address is taken from the A record by
+// ibclient.ObjectManager.
+func ptrRecordForA(ra ibclient.RecordA) ibclient.RecordPTR {
+	return ibclient.RecordPTR{
+		PtrdName: ra.Name,
+		View:     ra.View,
+		Ttl:      ra.Ttl,
+		UseTtl:   ra.UseTtl,
+		Comment:  ra.Comment,
+		Disable:  ra.Disable,
+		Ea:       ra.Ea,
+	}
+}
+
+// aRecordPTRRef returns the reference of the PTR record for the A record
+// fqdn with the address ipAddr, or an empty string when there is none. When
+// the only PTR record of fqdn has another address, as left behind when the
+// address of the A record changes outside of Terraform, it is returned as
+// stale so that it is updated or deleted rather than orphaned.
+func aRecordPTRRef(connector *ibclient.Connector, dnsView string, fqdn string, ipAddr string) (ref string, stale bool, err error) {
+	var records []ibclient.RecordPTR
+	search := ibclient.NewRecordPTR(ibclient.RecordPTR{View: dnsView, PtrdName: fqdn})
+	if err := connector.GetObject(search, "", &records); err != nil {
+		return "", false, err
+	}
+	for _, record := range records {
+		if record.Ipv4Addr == ipAddr {
+			return record.Ref, false, nil
+		}
+	}
+	if len(records) == 1 {
+		return records[0].Ref, true, nil
+	}
+	return "", false, nil
+}
+
 func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning to create A record from  required network block", resourceARecordIDString(d))
 
-	//This is for record Name
-	recordName := d.Get("vm_name").(string)
 	ipAddr := d.Get("ip_addr").(string)
 	cidr := d.Get("cidr").(string)
 	vmID := d.Get("vm_id").(string)
-	//This is for vm name
 	vmName := d.Get("vm_name").(string)
-	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
 	tenantID := d.Get("tenant_id").(string)
 	connector := m.(*ibclient.Connector)
 
-	ea := make(ibclient.EA)
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
 
-	ea["VM Name"] = vmName
+	if vmName != "" {
+		ea["VM Name"] = vmName
+	}
 
 	if vmID != "" {
 		ea["VM ID"] = vmID
@@ -84,15 +160,38 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-	// fqdn
-	name := recordName + "." + zone
-	recordA, err := objMgr.CreateARecord(dnsView, dnsView, name, cidr, ipAddr, ea)
+	name, err := vmRecordFQDN(d)
 	if err != nil {
-		return fmt.Errorf("Error creating A Record from network block(%s): %s", cidr, err)
+		return fmt.Errorf("Error creating A record: %s", err)
+	}
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordA := ibclient.RecordA{
+		Name:     name,
+		View:     dnsView,
+		Ipv4Addr: ipAddrForCreate(d, dnsView),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       ea,
+	}
+	if d.Get("create_ptr").(bool) {
+		ref, _, err := objMgr.CreateARecordWithPTR(recordA, ptrRecordForA(recordA))
+		if err != nil {
+			return fmt.Errorf("Error creating A and PTR Record from network block(%s): %s", cidr, err)
+		}
+		d.SetId(ref)
+	} else {
+		obj, err := objMgr.CreateARecordObject(recordA)
+		if err != nil {
+			return fmt.Errorf("Error creating A Record from network block(%s): %s", cidr, err)
+		}
+		d.SetId(obj.Ref)
 	}
 
 	d.Set("recordName", name)
-	d.SetId(recordA.Ref)
 
 	log.Printf("[DEBUG] %s: Creation of A Record complete", resourceARecordIDString(d))
 	return resourceARecordGet(d, m)
@@ -109,16 +208,127 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 
 	obj, err := objMgr.GetARecordByRef(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: A Record not found, removing it from state", resourceARecordIDString(d))
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Getting A record failed from dns view (%s) : %s", dnsView, err)
 	}
+	if !usesFQDNArg(d, "vm_name") {
+		recordName, zone := splitRecordName(obj.Name, obj.Zone)
+		d.Set("vm_name", recordName)
+		d.Set("zone", zone)
+	} else {
+		d.Set("fqdn", obj.Name)
+	}
+	d.Set("dns_view", obj.View)
+	d.Set("ip_addr", obj.Ipv4Addr)
+	d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	if d.Get("create_ptr").(bool) {
+		ptrRef, stale, err := aRecordPTRRef(connector, obj.View, obj.Name, obj.Ipv4Addr)
+		if err != nil {
+			return fmt.Errorf("Getting PTR record of A record failed from dns view (%s) : %s", dnsView, err)
+		}
+		// A stale PTR record is updated by the next apply.
+		if ptrRef == "" {
+			log.Printf("[WARN] %s: PTR Record of A Record not found", resourceARecordIDString(d))
+			d.Set("create_ptr", false)
+		} else if stale {
+			log.Printf("[WARN] %s: PTR Record of A Record points to another address", resourceARecordIDString(d))
+			d.Set("create_ptr", false)
+		}
+	}
+
 	d.SetId(obj.Ref)
 	log.Printf("[DEBUG] %s: Completed reading required A Record ", resourceARecordIDString(d))
 	return nil
 }
 
 func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of A Record", resourceARecordIDString(d))
 
-	return fmt.Errorf("updating A record is not supported")
+	vmID := d.Get("vm_id").(string)
+	vmName := d.Get("vm_name").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	if vmName != "" {
+		ea["VM Name"] = vmName
+	}
+
+	if vmID != "" {
+		ea["VM ID"] = vmID
+	}
+
+	name, err := vmRecordFQDN(d)
+	if err != nil {
+		return fmt.Errorf("Updating A Record failed: %s", err)
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordA := ibclient.RecordA{
+		Name:     name,
+		Ipv4Addr: ipAddrForUpdate(d, dnsView),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       ea,
+	}
+	// The PTR record is looked up by the name and address of the A record
+	// before the update. When create_ptr is turned on, a stale PTR record of
+	// the name is updated instead of creating another one.
+	oldCreatePTR, createPTR := d.GetChange("create_ptr")
+	ptrRef := ""
+	if oldCreatePTR.(bool) || createPTR.(bool) {
+		oldFQDN, _ := d.GetChange("fqdn")
+		oldVMName, _ := d.GetChange("vm_name")
+		oldZone, _ := d.GetChange("zone")
+		if oldVMName.(string) != "" {
+			oldFQDN = oldVMName.(string) + "." + oldZone.(string)
+		}
+		oldIPAddr, _ := d.GetChange("ip_addr")
+		ptrRef, _, err = aRecordPTRRef(connector, dnsView, oldFQDN.(string), oldIPAddr.(string))
+		if err != nil {
+			return fmt.Errorf("Getting PTR record of A record failed from dns view (%s) : %s", dnsView, err)
+		}
+	}
+
+	if createPTR.(bool) {
+		ref, _, err := objMgr.UpdateARecordWithPTR(d.Id(), recordA, ptrRef, ptrRecordForA(recordA))
+		if err != nil {
+			return fmt.Errorf("Updating A and PTR Record failed in dns view (%s) : %s", dnsView, err)
+		}
+		d.SetId(ref)
+	} else {
+		_, err = objMgr.UpdateARecord(d.Id(), recordA)
+		if err != nil {
+			return fmt.Errorf("Updating A Record failed in dns view (%s) : %s", dnsView, err)
+		}
+		if ptrRef != "" {
+			_, err = objMgr.DeletePTRRecord(ptrRef)
+			if err != nil {
+				return fmt.Errorf("Deletion of PTR Record of A Record failed from dns view(%s) : %s", dnsView, err)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Update of A Record complete", resourceARecordIDString(d))
+	return resourceARecordGet(d, m)
 }
 
 func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
@@ -130,9 +340,28 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	_, err := objMgr.DeleteARecord(d.Id())
-	if err != nil {
-		return fmt.Errorf("Deletion of A Record failed from dns view(%s) : %s", dnsView, err)
+	ptrRef := ""
+	if d.Get("create_ptr").(bool) {
+		name, err := vmRecordFQDN(d)
+		if err != nil {
+			return fmt.Errorf("Deletion of A Record failed: %s", err)
+		}
+		ptrRef, _, err = aRecordPTRRef(connector, dnsView, name, d.Get("ip_addr").(string))
+		if err != nil {
+			return fmt.Errorf("Getting PTR record of A record failed from dns view (%s) : %s", dnsView, err)
+		}
+	}
+
+	if ptrRef != "" {
+		err := objMgr.DeleteARecordWithPTR(d.Id(), ptrRef)
+		if err != nil {
+			return fmt.Errorf("Deletion of A and PTR Record failed from dns view(%s) : %s", dnsView, err)
+		}
+	} else {
+		_, err := objMgr.DeleteARecord(d.Id())
+		if err != nil {
+			return fmt.Errorf("Deletion of A Record failed from dns view(%s) : %s", dnsView, err)
+		}
 	}
 	d.SetId("")
 
@@ -140,6 +369,26 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceARecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:a") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordA(ibclient.RecordA{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceARecordIDStringInterface interface {
 	Id() string
 }
@@ -1,10 +1,13 @@
 package infoblox
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	ibclient "github.com/infobloxopen/infoblox-go-client"
 )
@@ -20,6 +23,9 @@ func TestAccResourceARecord(t *testing.T) {
 				Config: testAccresourceARecordCreate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccARecordExists(t, "infoblox_a_record.foo", "10.0.0.0/24", "10.0.0.2", "test", "demo-network", "default", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "ip_addr", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "zone", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "dns_view", "default"),
 				),
 			},
 			resource.TestStep{
@@ -35,10 +41,189 @@ func TestAccResourceARecord(t *testing.T) {
 					testAccARecordExists(t, "infoblox_a_record.foo", "10.0.0.0/24", "10.0.0.2", "test", "demo-network", "default", "a.com"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccresourceARecordUpdateInPlace,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "ip_addr", "10.0.0.3"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "vm_name", "test-name"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "comment", "cutover"),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "disable", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_a_record.foo",
+				ImportState:             true,
+				ImportStateId:           "default/test-name.a.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr"},
+			},
 		},
 	})
 }
 
+func TestAccResourceARecordFQDN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceARecordApex,
+				Check: resource.ComposeTestCheckFunc(
+					testAccARecordExists(t, "infoblox_a_record.apex", "", "10.0.0.4", "", "", "default", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.apex", "fqdn", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.apex", "vm_name", ""),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceARecordFQDNUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.apex", "fqdn", "www.a.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.apex", "ip_addr", "10.0.0.4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceARecordWithPTR(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceARecordWithPTR,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.ptr", "create_ptr", "true"),
+					testAccARecordPTRExists(t, "infoblox_a_record.ptr", "web.a.com", "10.0.0.5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceARecordWithPTRUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.ptr", "ip_addr", "10.0.0.6"),
+					testAccARecordPTRExists(t, "infoblox_a_record.ptr", "web.a.com", "10.0.0.6"),
+				),
+			},
+		},
+	})
+}
+
+func TestARecordUpdateMapping(t *testing.T) {
+	attributes := map[string]string{
+		"vm_name":    "web",
+		"zone":       "a.com",
+		"dns_view":   "default",
+		"ip_addr":    "10.0.0.5",
+		"cidr":       "10.0.0.0/24",
+		"create_ptr": "false",
+		"disable":    "false",
+		"tenant_id":  "foo",
+	}
+
+	cases := []struct {
+		raw      map[string]interface{}
+		ipv4addr interface{}
+	}{
+		{map[string]interface{}{"vm_name": "web", "zone": "a.com", "cidr": "10.0.1.0/24", "ttl": 300, "tenant_id": "foo"}, "func:nextavailableip:10.0.1.0/24,default"},
+		{map[string]interface{}{"vm_name": "web", "zone": "a.com", "cidr": "10.0.0.0/16", "ttl": 300, "tenant_id": "foo"}, nil},
+		{map[string]interface{}{"vm_name": "web", "zone": "a.com", "ip_addr": "10.0.0.9", "cidr": "10.0.0.0/24", "ttl": 300, "tenant_id": "foo"}, "10.0.0.9"},
+	}
+
+	for _, tc := range cases {
+		d := testResourceDataUpdate(t, resourceARecord(), attributes, tc.raw)
+		d.SetId("record:a/ZG5z:web.a.com/default")
+		connector, requestor := testConnector(
+			`"record:a/ZG5z:web.a.com/default"`,
+			`{"_ref": "record:a/ZG5z:web.a.com/default", "name": "web.a.com", "zone": "a.com", "view": "default",
+			  "ipv4addr": "10.0.1.7", "ttl": 300, "use_ttl": true, "extattrs": {"VM Name": {"value": "web"}}}`,
+		)
+
+		if err := resourceARecordUpdate(d, connector); err != nil {
+			t.Fatalf("resourceARecordUpdate(%v) returned error %v", tc.raw, err)
+		}
+
+		req := requestor.requests[0]
+		if req.method != "PUT" || !strings.Contains(req.url, "/record:a/ZG5z:web.a.com/default") {
+			t.Fatalf("update of %v sent %s %s", tc.raw, req.method, req.url)
+		}
+		obj := req.object(t)
+		if obj["name"] != "web.a.com" || obj["ttl"] != 300.0 || obj["use_ttl"] != true || obj["ipv4addr"] != tc.ipv4addr {
+			t.Fatalf("update of %v sent %v, expected ipv4addr %v", tc.raw, obj, tc.ipv4addr)
+		}
+
+		if d.Get("ip_addr") != "10.0.1.7" || d.Get("ttl") != 300 {
+			t.Fatalf("state has ip_addr %v and ttl %v", d.Get("ip_addr"), d.Get("ttl"))
+		}
+	}
+}
+
+func TestARecordStalePTR(t *testing.T) {
+	const aRef = "record:a/ZG5z:web.a.com/default"
+	const ptrRef = "record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default"
+	aRecord := `{"_ref": "record:a/ZG5z:web.a.com/default", "name": "web.a.com", "zone": "a.com",
+	  "view": "default", "ipv4addr": "10.0.0.9"}`
+	stalePTR := `[{"_ref": "record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default", "ptrdname": "web.a.com",
+	  "view": "default", "ipv4addr": "10.0.0.5"}]`
+
+	// The address of the A record changed outside of Terraform.
+	d := schema.TestResourceDataRaw(t, resourceARecord().Schema, map[string]interface{}{
+		"vm_name":    "web",
+		"zone":       "a.com",
+		"ip_addr":    "10.0.0.5",
+		"create_ptr": true,
+		"tenant_id":  "foo",
+	})
+	d.SetId(aRef)
+	connector, _ := testConnector(aRecord, stalePTR)
+	if err := resourceARecordGet(d, connector); err != nil {
+		t.Fatalf("resourceARecordGet returned error %v", err)
+	}
+	if d.Get("create_ptr") != false || d.Get("ip_addr") != "10.0.0.9" {
+		t.Fatalf("state has create_ptr %v and ip_addr %v, expected the stale PTR record to be reported", d.Get("create_ptr"), d.Get("ip_addr"))
+	}
+
+	// The next apply updates the stale PTR record instead of creating one.
+	d = testResourceDataUpdate(t, resourceARecord(), map[string]string{
+		"vm_name":    "web",
+		"zone":       "a.com",
+		"dns_view":   "default",
+		"ip_addr":    "10.0.0.9",
+		"create_ptr": "false",
+		"disable":    "false",
+		"tenant_id":  "foo",
+	}, map[string]interface{}{
+		"vm_name":    "web",
+		"zone":       "a.com",
+		"create_ptr": true,
+		"tenant_id":  "foo",
+	})
+	d.SetId(aRef)
+	connector, requestor := testConnector(
+		stalePTR,
+		`[{"A_REF": "record:a/ZG5z:web.a.com/default", "PTR_REF": "record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default"}]`,
+		aRecord,
+		`[{"_ref": "record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default", "ptrdname": "web.a.com",
+		  "view": "default", "ipv4addr": "10.0.0.9"}]`,
+	)
+	if err := resourceARecordUpdate(d, connector); err != nil {
+		t.Fatalf("resourceARecordUpdate returned error %v", err)
+	}
+
+	var bodies []map[string]interface{}
+	if err := json.Unmarshal([]byte(requestor.requests[1].body), &bodies); err != nil {
+		t.Fatalf("update sent an invalid request %q: %s", requestor.requests[1].body, err)
+	}
+	if len(bodies) < 2 || bodies[1]["method"] != "PUT" || bodies[1]["object"] != ptrRef {
+		t.Fatalf("update sent %v, expected the stale PTR record to be updated", bodies)
+	}
+	if d.Get("create_ptr") != true {
+		t.Fatalf("state has create_ptr %v after the update", d.Get("create_ptr"))
+	}
+}
+
 func testAccCheckARecordDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 
@@ -78,6 +263,24 @@ func testAccARecordExists(t *testing.T, n string, cidr string, ipAddr string, ne
 	}
 }
 
+func testAccARecordPTRExists(t *testing.T, n string, fqdn string, ipAddr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		Connector := testAccProvider.Meta().(*ibclient.Connector)
+		ptrRef, stale, err := aRecordPTRRef(Connector, rs.Primary.Attributes["dns_view"], fqdn, ipAddr)
+		if err != nil {
+			return err
+		}
+		if ptrRef == "" || stale {
+			return fmt.Errorf("PTR record of %s not found", fqdn)
+		}
+		return nil
+	}
+}
+
 var testAccresourceARecordCreate = fmt.Sprintf(`
 resource "infoblox_a_record" "foo"{
 	vm_name="test-name"
@@ -111,3 +314,46 @@ resource "infoblox_a_record" "foo"{
 	ip_addr="10.0.0.2"
 	tenant_id="foo"
 	}`)
+
+var testAccresourceARecordUpdateInPlace = fmt.Sprintf(`
+resource "infoblox_a_record" "foo"{
+	vm_name="test-name"
+	dns_view="default"
+	zone="a.com"
+	cidr="10.0.0.0/24"
+	ip_addr="10.0.0.3"
+	ttl=300
+	comment="cutover"
+	disable=true
+	tenant_id="foo"
+	}`)
+
+var testAccresourceARecordApex = fmt.Sprintf(`
+resource "infoblox_a_record" "apex"{
+	fqdn="a.com"
+	ip_addr="10.0.0.4"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceARecordFQDNUpdate = fmt.Sprintf(`
+resource "infoblox_a_record" "apex"{
+	fqdn="www.a.com"
+	ip_addr="10.0.0.4"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceARecordWithPTR = fmt.Sprintf(`
+resource "infoblox_a_record" "ptr"{
+	fqdn="web.a.com"
+	ip_addr="10.0.0.5"
+	create_ptr=true
+	tenant_id="foo"
+	}`)
+
+var testAccresourceARecordWithPTRUpdate = fmt.Sprintf(`
+resource "infoblox_a_record" "ptr"{
+	fqdn="web.a.com"
+	ip_addr="10.0.0.6"
+	create_ptr=true
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,263 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceAAAARecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAAAARecordCreate,
+		Read:   resourceAAAARecordGet,
+		Update: resourceAAAARecordUpdate,
+		Delete: resourceAAAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAAAARecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Network view to allocate IP address from when the ip_addr field is empty.",
+			},
+			"vm_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the VM.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IPv6 network to allocate IP address when the ip_addr field is empty. Network address in cidr format.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Zone under which record has to be created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"ip_addr": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "IPv6 address your instance in cloud. For static allocation, set the field with valid IP. For dynamic allocation, leave this field empty and set the cidr field.",
+				DiffSuppressFunc: suppressDynamicIPDiff,
+			},
+			"vm_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "instance id.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the AAAA record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the AAAA record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the AAAA record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the AAAA record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create AAAA record from  required network block", resourceAAAARecordIDString(d))
+
+	//This is for record Name
+	recordName := d.Get("vm_name").(string)
+	ipAddr := d.Get("ip_addr").(string)
+	cidr := d.Get("cidr").(string)
+	vmID := d.Get("vm_id").(string)
+	//This is for vm name
+	vmName := d.Get("vm_name").(string)
+	zone := d.Get("zone").(string)
+	dnsView := d.Get("dns_view").(string)
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	ea["VM Name"] = vmName
+
+	if vmID != "" {
+		ea["VM ID"] = vmID
+	}
+
+	if ipAddr == "" && cidr == "" {
+		return fmt.Errorf("Error creating AAAA record: neither ip_addr nor cidr value provided.")
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+	// fqdn
+	name := recordName + "." + zone
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordAAAA, err := objMgr.CreateAAAARecordObject(ibclient.RecordAAAA{
+		Name:     name,
+		View:     dnsView,
+		Ipv6Addr: ipAddrForCreate(d, networkViewName),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       ea,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating AAAA Record from network block(%s): %s", cidr, err)
+	}
+
+	d.SetId(recordAAAA.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of AAAA Record complete", resourceAAAARecordIDString(d))
+	return resourceAAAARecordGet(d, m)
+}
+
+func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get AAAA Record", resourceAAAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetAAAARecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: AAAA Record not found, removing it from state", resourceAAAARecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting AAAA record failed from dns view (%s) : %s", dnsView, err)
+	}
+	recordName, zone := splitRecordName(obj.Name, obj.Zone)
+	d.Set("vm_name", recordName)
+	d.Set("zone", zone)
+	d.Set("dns_view", obj.View)
+	d.Set("ip_addr", obj.Ipv6Addr)
+	d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required AAAA Record ", resourceAAAARecordIDString(d))
+	return nil
+}
+
+func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of AAAA Record", resourceAAAARecordIDString(d))
+
+	vmName := d.Get("vm_name").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	addEA, removeEA := vmExtAttrsChange(d)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordAAAA := ibclient.RecordAAAA{
+		Name:     vmName + "." + d.Get("zone").(string),
+		Ipv6Addr: ipAddrForUpdate(d, d.Get("network_view_name").(string)),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+	}
+	_, err := objMgr.UpdateAAAARecord(d.Id(), recordAAAA, addEA, removeEA)
+	if err != nil {
+		return fmt.Errorf("Updating AAAA Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of AAAA Record complete", resourceAAAARecordIDString(d))
+	return resourceAAAARecordGet(d, m)
+}
+
+func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of AAAA Record", resourceAAAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteAAAARecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of AAAA Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of AAAA Record complete", resourceAAAARecordIDString(d))
+	return nil
+}
+
+// resourceAAAARecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:aaaa") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordAAAA(ibclient.RecordAAAA{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceAAAARecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceAAAARecordIDString(d resourceAAAARecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_aaaa_record (ID = %s)", id)
+}
@@ -0,0 +1,108 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceAAAARecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAAAARecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceAAAARecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccAAAARecordExists(t, "infoblox_aaaa_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_aaaa_record.foo", "ip_addr", "2001:db8::2"),
+					resource.TestCheckResourceAttr("infoblox_aaaa_record.foo", "zone", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_aaaa_record.foo", "dns_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_aaaa_record.foo", "ttl", "600"),
+					resource.TestCheckResourceAttr("infoblox_aaaa_record.foo", "comment", "ipv6 cutover"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceAAAARecordAllocate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccAAAARecordExists(t, "infoblox_aaaa_record.foo1"),
+					resource.TestCheckResourceAttrSet("infoblox_aaaa_record.foo1", "ip_addr"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_aaaa_record.foo1",
+				ImportState:             true,
+				ImportStateId:           "default/test-name-v6.a.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr"},
+			},
+		},
+	})
+}
+
+func testAccCheckAAAARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_aaaa_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetAAAARecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("AAAA record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccAAAARecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetAAAARecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("AAAA record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceAAAARecordCreate = fmt.Sprintf(`
+resource "infoblox_aaaa_record" "foo"{
+	vm_name="test-name"
+	zone="a.com"
+	ip_addr="2001:db8::2"
+	ttl=600
+	comment="ipv6 cutover"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceAAAARecordAllocate = fmt.Sprintf(`
+resource "infoblox_ipv6_network" "net"{
+	cidr="2001:db8:40::/64"
+	tenant_id="foo"
+	}
+resource "infoblox_aaaa_record" "foo1"{
+	vm_name="test-name-v6"
+	zone="a.com"
+	ip_addr=""
+	cidr=infoblox_ipv6_network.net.cidr
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,244 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceCAARecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCAARecordCreate,
+		Read:   resourceCAARecordGet,
+		Update: resourceCAARecordUpdate,
+		Delete: resourceCAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCAARecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the record relative to the zone. Leave empty for the zone apex.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Zone under which record has to be created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"flag": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntBetween(0, 255),
+				Description:  "CAA flags, 128 marks the property as critical.",
+			},
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCAATag,
+				Description:  "The property of the record: issue, issuewild or iodef.",
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the property, e.g. the domain of a certificate authority.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the CAA record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the CAA record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the CAA record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the CAA record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func validateCAATag(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "issue", "issuewild", "iodef":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of issue, issuewild or iodef, got %q", k, v.(string)))
+	}
+	return
+}
+
+// buildCAARecord returns the CAA record described by the arguments in d,
+// without the DNS view which cannot be updated.
+func buildCAARecord(d *schema.ResourceData) ibclient.RecordCAA {
+	flag := uint(d.Get("flag").(int))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.RecordCAA{
+		Name:    recordFQDN(d.Get("name").(string), d.Get("zone").(string)),
+		CaFlag:  &flag,
+		CaTag:   d.Get("tag").(string),
+		CaValue: d.Get("value").(string),
+		Ttl:     ttl,
+		UseTtl:  useTTL,
+		Comment: &comment,
+		Disable: &disable,
+		Ea:      eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceCAARecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create CAA record", resourceCAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordCAA := buildCAARecord(d)
+	recordCAA.View = dnsView
+	obj, err := objMgr.CreateCAARecord(recordCAA)
+	if err != nil {
+		return fmt.Errorf("Error creating CAA Record (%s) in dns view (%s): %s", recordCAA.Name, dnsView, err)
+	}
+	d.SetId(obj.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of CAA Record complete", resourceCAARecordIDString(d))
+	return resourceCAARecordGet(d, m)
+}
+
+func resourceCAARecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get CAA Record", resourceCAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetCAARecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: CAA Record not found, removing it from state", resourceCAARecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting CAA record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("name", relativeRecordName(obj.Name, obj.Zone))
+	d.Set("zone", obj.Zone)
+	d.Set("dns_view", obj.View)
+	d.Set("flag", int(uintValue(obj.CaFlag)))
+	d.Set("tag", obj.CaTag)
+	d.Set("value", obj.CaValue)
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required CAA Record ", resourceCAARecordIDString(d))
+	return nil
+}
+
+func resourceCAARecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of CAA Record", resourceCAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateCAARecord(d.Id(), buildCAARecord(d))
+	if err != nil {
+		return fmt.Errorf("Updating CAA Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of CAA Record complete", resourceCAARecordIDString(d))
+	return resourceCAARecordGet(d, m)
+}
+
+func resourceCAARecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of CAA Record", resourceCAARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteCAARecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of CAA Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of CAA Record complete", resourceCAARecordIDString(d))
+	return nil
+}
+
+// resourceCAARecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID. A name with several CAA records can
+// only be imported by WAPI reference.
+func resourceCAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:caa") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordCAA(ibclient.RecordCAA{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceCAARecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceCAARecordIDString(d resourceCAARecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_caa_record (ID = %s)", id)
+}
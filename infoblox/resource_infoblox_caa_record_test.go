@@ -0,0 +1,122 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceCAARecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCAARecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceCAARecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCAARecordExists(t, "infoblox_caa_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "name", ""),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "zone", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "tag", "issue"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "value", "letsencrypt.org"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceCAARecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCAARecordExists(t, "infoblox_caa_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "tag", "iodef"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "value", "mailto:security@a.com"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "flag", "128"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.foo", "ext_attrs.Site", "HQ"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_caa_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateCAATag(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "issuewild",
+			f:   validateCAATag,
+		},
+		{
+			val:         "ISSUE",
+			f:           validateCAATag,
+			expectedErr: regexp.MustCompile("must be one of issue, issuewild or iodef"),
+		},
+	})
+}
+
+func testAccCheckCAARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_caa_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetCAARecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("CAA record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCAARecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetCAARecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("CAA record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceCAARecordCreate = fmt.Sprintf(`
+resource "infoblox_caa_record" "foo"{
+	zone="a.com"
+	tag="issue"
+	value="letsencrypt.org"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceCAARecordUpdate = fmt.Sprintf(`
+resource "infoblox_caa_record" "foo"{
+	zone="a.com"
+	flag=128
+	tag="iodef"
+	value="mailto:security@a.com"
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
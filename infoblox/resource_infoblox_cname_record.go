@@ -15,17 +15,28 @@ func resourceCNAMERecord() *schema.Resource {
 		Read:   resourceCNAMERecordGet,
 		Update: resourceCNAMERecordUpdate,
 		Delete: resourceCNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCNAMERecordImport,
+		},
+		CustomizeDiff: recordNameCustomizeDiff("alias"),
 
 		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"alias", "zone"},
+				Description:   "Fully qualified domain name of the alias. Alternative to alias and zone.",
+			},
 			"zone": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Zone under which record has to be created.",
 			},
 			"dns_view": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "default",
+				ForceNew:    true,
 				Description: "Dns View under which the zone has been created.",
 			},
 			"canonical": &schema.Schema{
@@ -35,7 +46,7 @@ func resourceCNAMERecord() *schema.Resource {
 			},
 			"alias": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "The alias name for the record.",
 			},
 			"vm_id": &schema.Schema{
@@ -43,6 +54,29 @@ func resourceCNAMERecord() *schema.Resource {
 				Optional:    true,
 				Description: "Instance id.",
 			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the CNAME record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the CNAME record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the CNAME record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the CNAME record.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,30 +85,63 @@ func resourceCNAMERecord() *schema.Resource {
 		},
 	}
 }
+
+// cnameRecordAlias returns the FQDN of the alias, given either by the fqdn
+// argument or by alias and zone. alias may already include the zone.
+func cnameRecordAlias(d *schema.ResourceData) (string, error) {
+	fqdn := d.Get("fqdn").(string)
+	alias := d.Get("alias").(string)
+	zone := d.Get("zone").(string)
+	if err := checkRecordName(fqdn, alias, zone, "alias"); err != nil {
+		return "", err
+	}
+	if alias == "" {
+		return fqdn, nil
+	}
+	if !strings.Contains(alias, zone) {
+		alias = alias + "." + zone
+	}
+	return alias, nil
+}
+
 func resourceCNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning to create CNAME record ", resourceCNAMERecordIDString(d))
 
-	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
 	canonical := d.Get("canonical").(string)
-	alias := d.Get("alias").(string)
-	if !strings.Contains(alias, zone) {
-		alias = d.Get("alias").(string) + "." + zone
-	}
 	tenantID := d.Get("tenant_id").(string)
 	vmId := d.Get("vm_id").(string)
 	connector := m.(*ibclient.Connector)
 
-	ea := make(ibclient.EA)
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
 
-	ea["VM Name"] = canonical
+	if d.Get("alias").(string) != "" {
+		ea["VM Name"] = canonical
+	}
 
 	if vmId != "" {
 		ea["VM ID"] = vmId
 	}
 
+	alias, err := cnameRecordAlias(d)
+	if err != nil {
+		return fmt.Errorf("Error creating CNAME Record : %s", err)
+	}
+
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-	recordCNAME, err := objMgr.CreateCNAMERecord(canonical, alias, dnsView, ea)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordCNAME, err := objMgr.CreateCNAMERecordObject(ibclient.RecordCNAME{
+		Name:      alias,
+		View:      dnsView,
+		Canonical: canonical,
+		Ttl:       ttl,
+		UseTtl:    useTTL,
+		Comment:   &comment,
+		Disable:   &disable,
+		Ea:        ea,
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating CNAME Record : %s", err)
 	}
@@ -97,16 +164,83 @@ func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
 
 	obj, err := objMgr.GetCNAMERecordByRef(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: CNAME Record not found, removing it from state", resourceCNAMERecordIDString(d))
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Getting CNAME RECORD failed from dns view(%s) : %s", dnsView, err)
 	}
+	if !usesFQDNArg(d, "alias") {
+		// alias may be configured either with or without the zone suffix.
+		alias := obj.Name
+		if !strings.Contains(d.Get("alias").(string), obj.Zone) {
+			alias, _ = splitRecordName(obj.Name, obj.Zone)
+		}
+		d.Set("alias", alias)
+		d.Set("zone", obj.Zone)
+	} else {
+		d.Set("fqdn", obj.Name)
+	}
+	d.Set("dns_view", obj.View)
+	d.Set("canonical", obj.Canonical)
+	d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
 	d.SetId(obj.Ref)
 	log.Printf("[DEBUG] %s: Completed reading required CNAME Record ", resourceCNAMERecordIDString(d))
 	return nil
 }
 
 func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of CNAME Record", resourceCNAMERecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	canonical := d.Get("canonical").(string)
+	tenantID := d.Get("tenant_id").(string)
+	vmId := d.Get("vm_id").(string)
+	connector := m.(*ibclient.Connector)
 
-	return fmt.Errorf("updating CNAME record is not supported")
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	if d.Get("alias").(string) != "" {
+		ea["VM Name"] = canonical
+	}
+
+	if vmId != "" {
+		ea["VM ID"] = vmId
+	}
+
+	alias, err := cnameRecordAlias(d)
+	if err != nil {
+		return fmt.Errorf("Updating CNAME Record failed: %s", err)
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordCNAME := ibclient.RecordCNAME{
+		Name:      alias,
+		Canonical: canonical,
+		Ttl:       ttl,
+		UseTtl:    useTTL,
+		Comment:   &comment,
+		Disable:   &disable,
+		Ea:        ea,
+	}
+	_, err = objMgr.UpdateCNAMERecord(d.Id(), recordCNAME)
+	if err != nil {
+		return fmt.Errorf("Updating CNAME Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of CNAME Record complete", resourceCNAMERecordIDString(d))
+	return resourceCNAMERecordGet(d, m)
 }
 
 func resourceCNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
@@ -128,6 +262,26 @@ func resourceCNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceCNAMERecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID, where fqdn is the alias of the record.
+func resourceCNAMERecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:cname") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordCNAME(ibclient.RecordCNAME{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceCNAMERecordIDStringInterface interface {
 	Id() string
 }
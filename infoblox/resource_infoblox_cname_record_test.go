@@ -3,8 +3,10 @@ package infoblox
 import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/infobloxopen/infoblox-go-client"
+	"strings"
 	"testing"
 )
 
@@ -24,13 +26,105 @@ func TestAccResourceCNAMERecord(t *testing.T) {
 			resource.TestStep{
 				Config: testAccresourceCNAMERecordUpdate,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCNAMERecordExists(t, "infoblox_cname_record.foo", "test", "test-name", "default", "a.com"),
+					testAccCNAMERecordExists(t, "infoblox_cname_record.foo", "test", "test-name2", "default", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_cname_record.foo", "canonical", "test-name2"),
+					resource.TestCheckResourceAttr("infoblox_cname_record.foo", "ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_cname_record.foo", "comment", "cutover"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "infoblox_cname_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/test.a.com",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestAccResourceCNAMERecordFQDN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCNAMERecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceCNAMERecordFQDN,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCNAMERecordExists(t, "infoblox_cname_record.fqdn", "www", "web.a.com", "default", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_cname_record.fqdn", "fqdn", "www.a.com"),
+					resource.TestCheckResourceAttr("infoblox_cname_record.fqdn", "alias", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestCNAMERecordAlias(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected string
+		err      bool
+	}{
+		{map[string]interface{}{"alias": "www", "zone": "a.com"}, "www.a.com", false},
+		{map[string]interface{}{"alias": "www.a.com", "zone": "a.com"}, "www.a.com", false},
+		{map[string]interface{}{"fqdn": "www.a.com"}, "www.a.com", false},
+		{map[string]interface{}{"zone": "a.com"}, "", true},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceCNAMERecord().Schema, tc.raw)
+		res, err := cnameRecordAlias(d)
+		if (err != nil) != tc.err {
+			t.Fatalf("cnameRecordAlias(%v) returned error %v", tc.raw, err)
+		}
+		if res != tc.expected {
+			t.Fatalf("cnameRecordAlias(%v) returned %q, expected %q", tc.raw, res, tc.expected)
+		}
+	}
+}
+
+func TestCNAMERecordUpdateMapping(t *testing.T) {
+	d := testResourceDataUpdate(t, resourceCNAMERecord(), map[string]string{
+		"alias":     "www",
+		"zone":      "a.com",
+		"dns_view":  "default",
+		"canonical": "web1.a.com",
+		"disable":   "false",
+		"tenant_id": "foo",
+	}, map[string]interface{}{
+		"alias":     "www",
+		"zone":      "a.com",
+		"canonical": "web2.a.com",
+		"ttl":       0,
+		"disable":   true,
+		"tenant_id": "foo",
+	})
+	d.SetId("record:cname/ZG5z:www.a.com/default")
+	connector, requestor := testConnector(
+		`"record:cname/ZG5z:www.a.com/default"`,
+		`{"_ref": "record:cname/ZG5z:www.a.com/default", "name": "www.a.com", "zone": "a.com",
+		  "view": "default", "canonical": "web2.a.com", "ttl": 0, "use_ttl": true, "disable": true}`,
+	)
+
+	if err := resourceCNAMERecordUpdate(d, connector); err != nil {
+		t.Fatalf("resourceCNAMERecordUpdate returned error %v", err)
+	}
+
+	req := requestor.requests[0]
+	if req.method != "PUT" || !strings.Contains(req.url, "/record:cname/ZG5z:www.a.com/default") {
+		t.Fatalf("update sent %s %s", req.method, req.url)
+	}
+	obj := req.object(t)
+	if obj["name"] != "www.a.com" || obj["canonical"] != "web2.a.com" || obj["disable"] != true {
+		t.Fatalf("update sent %v", obj)
+	}
+
+	if d.Get("canonical") != "web2.a.com" || d.Get("disable") != true || d.Get("alias") != "www" {
+		t.Fatalf("state has canonical %v, disable %v and alias %v", d.Get("canonical"), d.Get("disable"), d.Get("alias"))
+	}
+}
+
 func testAccCheckCNAMERecordDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 
@@ -82,8 +176,17 @@ resource "infoblox_cname_record" "foo"{
 var testAccresourceCNAMERecordUpdate = fmt.Sprintf(`
 resource "infoblox_cname_record" "foo"{
 	alias="test"
-	canonical="test-name"
+	canonical="test-name2"
+	ttl=300
+	comment="cutover"
 	dns_view="default"
 	zone="a.com"
 	tenant_id="foo"
 	}`)
+
+var testAccresourceCNAMERecordFQDN = fmt.Sprintf(`
+resource "infoblox_cname_record" "fqdn"{
+	fqdn="www.a.com"
+	canonical="web.a.com"
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,507 @@
+package infoblox
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+// dhcpRangeAllocationAttempts is the number of blocks tried when a range
+// of allocate_size addresses is created.
+const dhcpRangeAllocationAttempts = 3
+
+func resourceDHCPRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDHCPRangeCreate,
+		Read:   resourceDHCPRangeRead,
+		Update: resourceDHCPRangeUpdate,
+		Delete: resourceDHCPRangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDHCPRangeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The network of the range in cidr format. Required with allocate_size.",
+			},
+			"start_addr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"allocate_size"},
+				Description:   "The first address of the range.",
+			},
+			"end_addr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"allocate_size"},
+				Description:   "The last address of the range.",
+			},
+			"allocate_size": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Default:       0,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_addr", "end_addr"},
+				Description:   "Set parameter value>0 to create the range with this number of addresses in free space of the network cidr.",
+			},
+			"member": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"failover_association"},
+				Description:   "The host name of the grid member serving the range.",
+			},
+			"failover_association": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"member"},
+				Description:   "The name of the DHCP failover association serving the range.",
+			},
+			"dhcp_option": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionResource(),
+				Description: "DHCP options sent to the clients of the range.",
+			},
+			"exclude": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        exclusionRangeResource(),
+				Description: "Parts of the range which are not leased to clients.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the range.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the range without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the range.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func exclusionRangeResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start_addr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The first excluded address.",
+			},
+			"end_addr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The last excluded address.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the exclusion.",
+			},
+		},
+	}
+}
+
+// exclusionRanges converts an exclude list to exclusion ranges.
+func exclusionRanges(items []interface{}) []ibclient.ExclusionRange {
+	res := []ibclient.ExclusionRange{}
+	for _, v := range items {
+		item := v.(map[string]interface{})
+		comment := item["comment"].(string)
+		res = append(res, ibclient.ExclusionRange{
+			StartAddress: item["start_addr"].(string),
+			EndAddress:   item["end_addr"].(string),
+			Comment:      &comment,
+		})
+	}
+	return res
+}
+
+// exclusionRangesForState converts exclusion ranges returned by NIOS to an
+// exclude list.
+func exclusionRangesForState(items *[]ibclient.ExclusionRange) []interface{} {
+	res := make([]interface{}, 0)
+	if items == nil {
+		return res
+	}
+	for _, item := range *items {
+		res = append(res, map[string]interface{}{
+			"start_addr": item.StartAddress,
+			"end_addr":   item.EndAddress,
+			"comment":    stringValue(item.Comment),
+		})
+	}
+	return res
+}
+
+// ipv4ToUint returns the IPv4 address ip as a number, and false when ip is
+// not an IPv4 address.
+func ipv4ToUint(ip net.IP) (uint32, bool) {
+	ip = ip.To4()
+	if ip == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(ip), true
+}
+
+// uintToIPv4 is the inverse of ipv4ToUint.
+func uintToIPv4(n uint32) string {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, n)
+	return ip.String()
+}
+
+// nextAvailableRange returns the first and last address of the first block
+// of size addresses in the network cidr which overlaps none of the used
+// address blocks. The network and broadcast addresses are never part of
+// the block.
+func nextAvailableRange(cidr string, size int, used [][2]string) (string, string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", "", err
+	}
+	base, ok := ipv4ToUint(network.IP)
+	ones, bits := network.Mask.Size()
+	if !ok || bits != 32 {
+		return "", "", fmt.Errorf("%s is not an IPv4 network", cidr)
+	}
+	first := int64(base) + 1
+	last := int64(base) + int64(1)<<uint(bits-ones) - 2
+
+	blocks := make([][2]int64, 0, len(used))
+	for _, block := range used {
+		start, ok1 := ipv4ToUint(net.ParseIP(block[0]))
+		end, ok2 := ipv4ToUint(net.ParseIP(block[1]))
+		if !ok1 || !ok2 {
+			return "", "", fmt.Errorf("invalid address block %s-%s", block[0], block[1])
+		}
+		blocks = append(blocks, [2]int64{int64(start), int64(end)})
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i][0] < blocks[j][0] })
+
+	start := first
+	for _, block := range blocks {
+		if block[0]-start >= int64(size) {
+			break
+		}
+		if block[1]+1 > start {
+			start = block[1] + 1
+		}
+	}
+	if last-start+1 < int64(size) {
+		return "", "", fmt.Errorf("No free block of %d addresses in network %s", size, cidr)
+	}
+	return uintToIPv4(uint32(start)), uintToIPv4(uint32(start + int64(size) - 1)), nil
+}
+
+// usedAddressBlocks returns the address blocks of the network cidr which
+// cannot be part of a new range: the other ranges, the fixed addresses and
+// the addresses of host records. The range referenced by ref is left out.
+func usedAddressBlocks(objMgr *ibclient.ObjectManager, networkViewName string, cidr string, ref string) ([][2]string, error) {
+	ranges, err := objMgr.GetRanges(networkViewName, cidr)
+	if err != nil {
+		return nil, err
+	}
+	fixedAddrs, err := objMgr.GetFixedAddresses(networkViewName, cidr)
+	if err != nil {
+		return nil, err
+	}
+	hostAddrs, err := objMgr.GetHostRecordIpv4Addrs(networkViewName, cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make([][2]string, 0, len(ranges)+len(fixedAddrs)+len(hostAddrs))
+	for _, r := range ranges {
+		if r.Ref != ref {
+			used = append(used, [2]string{r.StartAddr, r.EndAddr})
+		}
+	}
+	for _, fixedAddr := range fixedAddrs {
+		used = append(used, [2]string{fixedAddr.IPAddress, fixedAddr.IPAddress})
+	}
+	for _, hostAddr := range hostAddrs {
+		used = append(used, [2]string{hostAddr.Ipv4Addr, hostAddr.Ipv4Addr})
+	}
+	return used, nil
+}
+
+// blockOverlaps reports whether the block from startAddr to endAddr
+// overlaps one of the used blocks.
+func blockOverlaps(startAddr string, endAddr string, used [][2]string) bool {
+	start, _ := ipv4ToUint(net.ParseIP(startAddr))
+	end, _ := ipv4ToUint(net.ParseIP(endAddr))
+	for _, block := range used {
+		blockStart, ok1 := ipv4ToUint(net.ParseIP(block[0]))
+		blockEnd, ok2 := ipv4ToUint(net.ParseIP(block[1]))
+		if ok1 && ok2 && blockStart <= end && blockEnd >= start {
+			return true
+		}
+	}
+	return false
+}
+
+// createAllocatedRange creates the range r on a free block of size addresses
+// in its network. The block is searched before the range is created, so an
+// address may be taken in between: when the created range, or a failed
+// creation, conflicts with another object, the range is deleted and another
+// block is tried.
+func createAllocatedRange(objMgr *ibclient.ObjectManager, r ibclient.Range, size int) (*ibclient.Range, error) {
+	var used [][2]string
+	var err error
+	for attempt := 0; attempt < dhcpRangeAllocationAttempts; attempt++ {
+		used, err = usedAddressBlocks(objMgr, r.NetviewName, r.Cidr, "")
+		if err != nil {
+			return nil, err
+		}
+		r.StartAddr, r.EndAddr, err = nextAvailableRange(r.Cidr, size, used)
+		if err != nil {
+			return nil, err
+		}
+
+		dhcpRange, createErr := objMgr.CreateRange(r)
+		used, err = usedAddressBlocks(objMgr, r.NetviewName, r.Cidr, dhcpRange.Ref)
+		if err != nil {
+			if createErr != nil {
+				return nil, createErr
+			}
+			return nil, err
+		}
+		if !blockOverlaps(r.StartAddr, r.EndAddr, used) {
+			if createErr != nil {
+				return nil, createErr
+			}
+			return dhcpRange, nil
+		}
+
+		err = fmt.Errorf("block %s-%s was taken while the range was created", r.StartAddr, r.EndAddr)
+		if createErr == nil {
+			log.Printf("[WARN] DHCP range %s-%s conflicts with another object, deleting it", r.StartAddr, r.EndAddr)
+			if _, deleteErr := objMgr.DeleteRange(dhcpRange.Ref); deleteErr != nil {
+				return nil, fmt.Errorf("%s, and deleting the range failed: %s", err, deleteErr)
+			}
+		}
+	}
+	return nil, err
+}
+
+// buildDHCPRange returns the settings of the range which can be changed
+// after creation.
+func buildDHCPRange(d *schema.ResourceData) ibclient.Range {
+	options := dhcpOptions(d.Get("dhcp_option").([]interface{}))
+	exclude := exclusionRanges(d.Get("exclude").([]interface{}))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+
+	dhcpRange := ibclient.Range{
+		StartAddr:             d.Get("start_addr").(string),
+		EndAddr:               d.Get("end_addr").(string),
+		ServerAssociationType: "NONE",
+		Options:               &options,
+		Exclude:               &exclude,
+		Comment:               &comment,
+		Disable:               &disable,
+		Ea:                    eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+	if member := d.Get("member").(string); member != "" {
+		dhcpRange.ServerAssociationType = "MEMBER"
+		dhcpRange.Member = &ibclient.DhcpMember{Name: member}
+	} else if failover := d.Get("failover_association").(string); failover != "" {
+		dhcpRange.ServerAssociationType = "FAILOVER"
+		dhcpRange.FailoverAssociation = &failover
+	}
+
+	return dhcpRange
+}
+
+func resourceDHCPRangeCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create DHCP range", resourceDHCPRangeIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	allocateSize := d.Get("allocate_size").(int)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	r := buildDHCPRange(d)
+	r.NetviewName = networkViewName
+	r.Cidr = cidr
+	var dhcpRange *ibclient.Range
+	var err error
+	if allocateSize > 0 {
+		if cidr == "" {
+			return fmt.Errorf("Creation of DHCP range failed: cidr is required with allocate_size")
+		}
+		dhcpRange, err = createAllocatedRange(objMgr, r, allocateSize)
+		if err != nil {
+			return fmt.Errorf("Allocation of DHCP range failed in network (%s) : %s", cidr, err)
+		}
+	} else {
+		if r.StartAddr == "" || r.EndAddr == "" {
+			return fmt.Errorf("Creation of DHCP range failed: neither start_addr and end_addr nor allocate_size was specified.")
+		}
+		dhcpRange, err = objMgr.CreateRange(r)
+		if err != nil {
+			return fmt.Errorf("Creation of DHCP range (%s-%s) failed in network view (%s) : %s", r.StartAddr, r.EndAddr, networkViewName, err)
+		}
+	}
+	d.SetId(dhcpRange.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of DHCP range complete", resourceDHCPRangeIDString(d))
+	return resourceDHCPRangeRead(d, m)
+}
+
+func resourceDHCPRangeRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required DHCP range", resourceDHCPRangeIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetRangeByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: DHCP range not found, removing it from state", resourceDHCPRangeIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting DHCP range failed from network view (%s) : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	d.Set("start_addr", obj.StartAddr)
+	d.Set("end_addr", obj.EndAddr)
+	member := ""
+	if obj.ServerAssociationType == "MEMBER" && obj.Member != nil {
+		member = obj.Member.Name
+	}
+	d.Set("member", member)
+	failover := ""
+	if obj.ServerAssociationType == "FAILOVER" {
+		failover = stringValue(obj.FailoverAssociation)
+	}
+	d.Set("failover_association", failover)
+	if err := d.Set("dhcp_option", dhcpOptionsForState(d.Get("dhcp_option").([]interface{}), obj.Options)); err != nil {
+		return err
+	}
+	if err := d.Set("exclude", exclusionRangesForState(obj.Exclude)); err != nil {
+		return err
+	}
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading DHCP range", resourceDHCPRangeIDString(d))
+	return nil
+}
+
+func resourceDHCPRangeUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of DHCP range", resourceDHCPRangeIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	// The reference of the range changes with its addresses.
+	dhcpRange, err := objMgr.UpdateRange(d.Id(), buildDHCPRange(d))
+	if err != nil {
+		return fmt.Errorf("Update of DHCP range failed in network view (%s) : %s", networkViewName, err)
+	}
+	d.SetId(dhcpRange.Ref)
+
+	log.Printf("[DEBUG] %s: Update of DHCP range complete", resourceDHCPRangeIDString(d))
+	return resourceDHCPRangeRead(d, m)
+}
+
+func resourceDHCPRangeDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of DHCP range", resourceDHCPRangeIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteRange(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of DHCP range failed from network view(%s) : %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of DHCP range complete", resourceDHCPRangeIDString(d))
+	return nil
+}
+
+// resourceDHCPRangeImport accepts either a WAPI reference or
+// <network_view>/<start_addr> as the import ID.
+func resourceDHCPRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "range") {
+		networkViewName, startAddr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRange(ibclient.Range{NetviewName: networkViewName, StartAddr: startAddr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+	d.Set("allocate_size", 0)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceDHCPRangeIDStringInterface interface {
+	Id() string
+}
+
+func resourceDHCPRangeIDString(d resourceDHCPRangeIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_dhcp_range (ID = %s)", id)
+}
@@ -0,0 +1,256 @@
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceDHCPRange(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDHCPRangeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceDHCPRangeCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPRangeExists(t, "infoblox_dhcp_range.foo"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "cidr", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "start_addr", "10.0.0.100"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "end_addr", "10.0.0.150"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "dhcp_option.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "dhcp_option.0.num", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceDHCPRangeUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPRangeExists(t, "infoblox_dhcp_range.foo"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "end_addr", "10.0.0.200"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "exclude.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "exclude.0.start_addr", "10.0.0.120"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.foo", "comment", "pool"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_dhcp_range.foo",
+				ImportState:       true,
+				ImportStateId:     "default/10.0.0.100",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDHCPRangeAllocate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDHCPRangeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceDHCPRangeAllocate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPRangeExists(t, "infoblox_dhcp_range.first"),
+					testAccDHCPRangeExists(t, "infoblox_dhcp_range.second"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.first", "start_addr", "10.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.first", "end_addr", "10.0.0.50"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.second", "start_addr", "10.0.0.51"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_range.second", "end_addr", "10.0.0.100"),
+				),
+			},
+		},
+	})
+}
+
+func TestNextAvailableRange(t *testing.T) {
+	cases := []struct {
+		cidr  string
+		size  int
+		used  [][2]string
+		start string
+		end   string
+		err   bool
+	}{
+		{"10.0.0.0/24", 10, nil, "10.0.0.1", "10.0.0.10", false},
+		{"10.0.0.0/24", 10, [][2]string{{"10.0.0.1", "10.0.0.1"}}, "10.0.0.2", "10.0.0.11", false},
+		{"10.0.0.0/24", 10, [][2]string{{"10.0.0.20", "10.0.0.30"}, {"10.0.0.1", "10.0.0.5"}}, "10.0.0.6", "10.0.0.15", false},
+		{"10.0.0.0/24", 20, [][2]string{{"10.0.0.20", "10.0.0.30"}, {"10.0.0.1", "10.0.0.5"}}, "10.0.0.31", "10.0.0.50", false},
+		{"10.0.0.0/24", 254, nil, "10.0.0.1", "10.0.0.254", false},
+		{"10.0.0.0/24", 255, nil, "", "", true},
+		{"10.0.0.0/24", 10, [][2]string{{"10.0.0.1", "10.0.0.250"}}, "", "", true},
+		{"2001:db8::/64", 10, nil, "", "", true},
+	}
+
+	for _, tc := range cases {
+		start, end, err := nextAvailableRange(tc.cidr, tc.size, tc.used)
+		if (err != nil) != tc.err {
+			t.Fatalf("nextAvailableRange(%q, %d, %v) returned error %v", tc.cidr, tc.size, tc.used, err)
+		}
+		if start != tc.start || end != tc.end {
+			t.Fatalf("nextAvailableRange(%q, %d, %v) returned (%q, %q), expected (%q, %q)",
+				tc.cidr, tc.size, tc.used, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+func TestCreateAllocatedRange(t *testing.T) {
+	connector, requestor := testConnector(
+		// The first block is taken by a host record while the range is
+		// created.
+		`[{"_ref": "range/ZG5z:10.0.0.1/default", "start_addr": "10.0.0.1", "end_addr": "10.0.0.10"}]`,
+		`[{"_ref": "fixedaddress/ZG5z:10.0.0.11/default", "ipv4addr": "10.0.0.11"}]`,
+		`[{"_ref": "record:host_ipv4addr/ZG5z:10.0.0.12/a.com", "ipv4addr": "10.0.0.12"}]`,
+		`"range/ZG5z:10.0.0.13/default"`,
+		`[{"_ref": "range/ZG5z:10.0.0.1/default", "start_addr": "10.0.0.1", "end_addr": "10.0.0.10"},
+		  {"_ref": "range/ZG5z:10.0.0.13/default", "start_addr": "10.0.0.13", "end_addr": "10.0.0.22"}]`,
+		`[{"_ref": "fixedaddress/ZG5z:10.0.0.11/default", "ipv4addr": "10.0.0.11"}]`,
+		`[{"_ref": "record:host_ipv4addr/ZG5z:10.0.0.12/a.com", "ipv4addr": "10.0.0.12"},
+		  {"_ref": "record:host_ipv4addr/ZG5z:10.0.0.15/b.com", "ipv4addr": "10.0.0.15"}]`,
+		`"range/ZG5z:10.0.0.13/default"`,
+		// The second block is free.
+		`[{"_ref": "range/ZG5z:10.0.0.1/default", "start_addr": "10.0.0.1", "end_addr": "10.0.0.10"}]`,
+		`[{"_ref": "fixedaddress/ZG5z:10.0.0.11/default", "ipv4addr": "10.0.0.11"}]`,
+		`[{"_ref": "record:host_ipv4addr/ZG5z:10.0.0.12/a.com", "ipv4addr": "10.0.0.12"},
+		  {"_ref": "record:host_ipv4addr/ZG5z:10.0.0.15/b.com", "ipv4addr": "10.0.0.15"}]`,
+		`"range/ZG5z:10.0.0.16/default"`,
+		`[{"_ref": "range/ZG5z:10.0.0.1/default", "start_addr": "10.0.0.1", "end_addr": "10.0.0.10"},
+		  {"_ref": "range/ZG5z:10.0.0.16/default", "start_addr": "10.0.0.16", "end_addr": "10.0.0.25"}]`,
+		`[{"_ref": "fixedaddress/ZG5z:10.0.0.11/default", "ipv4addr": "10.0.0.11"}]`,
+		`[{"_ref": "record:host_ipv4addr/ZG5z:10.0.0.12/a.com", "ipv4addr": "10.0.0.12"},
+		  {"_ref": "record:host_ipv4addr/ZG5z:10.0.0.15/b.com", "ipv4addr": "10.0.0.15"}]`,
+	)
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "foo")
+
+	dhcpRange, err := createAllocatedRange(objMgr, ibclient.Range{NetviewName: "default", Cidr: "10.0.0.0/24"}, 10)
+	if err != nil {
+		t.Fatalf("createAllocatedRange returned error %v", err)
+	}
+	if dhcpRange.Ref != "range/ZG5z:10.0.0.16/default" {
+		t.Fatalf("createAllocatedRange returned range %v", dhcpRange.Ref)
+	}
+
+	hostQuery := requestor.requests[2]
+	if !strings.Contains(hostQuery.url, "/record:host_ipv4addr") {
+		t.Fatalf("expected a host address query, got %s %s", hostQuery.method, hostQuery.url)
+	}
+	if obj := hostQuery.object(t); obj["network"] != "10.0.0.0/24" || obj["network_view"] != "default" {
+		t.Fatalf("host address query sent %v", obj)
+	}
+	if obj := requestor.requests[3].object(t); obj["start_addr"] != "10.0.0.13" || obj["end_addr"] != "10.0.0.22" {
+		t.Fatalf("first create request sent %v", obj)
+	}
+	if req := requestor.requests[7]; req.method != "DELETE" || !strings.HasSuffix(req.url, "/range/ZG5z:10.0.0.13/default") {
+		t.Fatalf("expected the conflicting range to be deleted, got %s %s", req.method, req.url)
+	}
+	if obj := requestor.requests[11].object(t); obj["start_addr"] != "10.0.0.16" || obj["end_addr"] != "10.0.0.25" {
+		t.Fatalf("second create request sent %v", obj)
+	}
+	if len(requestor.responses) != 0 {
+		t.Fatalf("%d responses left", len(requestor.responses))
+	}
+}
+
+func testAccCheckDHCPRangeDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dhcp_range" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetRangeByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DHCP range still exists")
+		}
+	}
+	return nil
+}
+
+func testAccDHCPRangeExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetRangeByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("DHCP range not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceDHCPRangeCreate = fmt.Sprintf(`
+resource "infoblox_network" "net"{
+	cidr="10.0.0.0/24"
+	gateway="none"
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_range" "foo"{
+	cidr=infoblox_network.net.cidr
+	start_addr="10.0.0.100"
+	end_addr="10.0.0.150"
+	dhcp_option {
+		name="routers"
+		num=3
+		value="10.0.0.1"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceDHCPRangeUpdate = fmt.Sprintf(`
+resource "infoblox_network" "net"{
+	cidr="10.0.0.0/24"
+	gateway="none"
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_range" "foo"{
+	cidr=infoblox_network.net.cidr
+	start_addr="10.0.0.100"
+	end_addr="10.0.0.200"
+	dhcp_option {
+		name="routers"
+		num=3
+		value="10.0.0.1"
+	}
+	exclude {
+		start_addr="10.0.0.120"
+		end_addr="10.0.0.129"
+	}
+	comment="pool"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceDHCPRangeAllocate = fmt.Sprintf(`
+resource "infoblox_network" "net"{
+	cidr="10.0.0.0/24"
+	gateway="none"
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_range" "first"{
+	cidr=infoblox_network.net.cidr
+	allocate_size=50
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_range" "second"{
+	cidr=infoblox_dhcp_range.first.cidr
+	allocate_size=50
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,218 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceDHCPReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDHCPReservationCreate,
+		Read:   resourceDHCPReservationRead,
+		Update: resourceDHCPReservationUpdate,
+		Delete: resourceDHCPReservationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDHCPReservationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The network to reserve the IP address in when the ip_addr field is empty. Network address in cidr format.",
+			},
+			"ip_addr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "IP address of the reservation. For dynamic allocation, leave this field empty and set the cidr field.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the reservation.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the reservation.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the reservation.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceDHCPReservationCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create DHCP reservation", resourceDHCPReservationIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	ipAddr := d.Get("ip_addr").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	if ipAddr == "" && cidr == "" {
+		return fmt.Errorf("Error creating DHCP reservation: neither ip_addr nor cidr value provided.")
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	fixedAddr, err := objMgr.ReserveIP(networkViewName, cidr, ipAddr, name, comment, extAttrs)
+	if err != nil {
+		return fmt.Errorf("Error creating DHCP reservation in network block(%s): %s", cidr, err)
+	}
+
+	d.SetId(fixedAddr.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of DHCP reservation complete", resourceDHCPReservationIDString(d))
+	return resourceDHCPReservationRead(d, m)
+}
+
+func resourceDHCPReservationRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to Get DHCP reservation", resourceDHCPReservationIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := getReservation(objMgr, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: DHCP reservation not found, removing it from state", resourceDHCPReservationIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting DHCP reservation failed from network view (%s) : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	d.Set("ip_addr", obj.IPAddress)
+	d.Set("name", stringValue(obj.Name))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading DHCP reservation", resourceDHCPReservationIDString(d))
+	return nil
+}
+
+func resourceDHCPReservationUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of DHCP reservation", resourceDHCPReservationIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateReservation(d.Id(), ibclient.FixedAddress{
+		Name:    &name,
+		Comment: &comment,
+		Ea:      eaFromExtAttrs(d.Get("ext_attrs")),
+	})
+	if err != nil {
+		return fmt.Errorf("Update of DHCP reservation failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of DHCP reservation complete", resourceDHCPReservationIDString(d))
+	return resourceDHCPReservationRead(d, m)
+}
+
+func resourceDHCPReservationDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of DHCP reservation", resourceDHCPReservationIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteFixedAddress(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of DHCP reservation failed from network view(%s): %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of DHCP reservation complete", resourceDHCPReservationIDString(d))
+	return nil
+}
+
+// resourceDHCPReservationImport accepts either a WAPI reference or
+// <network_view>/<ip_addr> as the import ID.
+func resourceDHCPReservationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "fixedaddress") {
+		networkViewName, ipAddr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		search := ibclient.NewFixedAddress(ibclient.FixedAddress{NetviewName: networkViewName, IPAddress: ipAddr, MatchClient: "RESERVED"})
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	} else if _, err := getReservation(ibclient.NewObjectManager(connector, "Terraform", ""), d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getReservation returns the fixed address referenced by ref, which must be
+// a reservation: other fixed addresses are managed by infoblox_ip_allocation.
+func getReservation(objMgr *ibclient.ObjectManager, ref string) (*ibclient.FixedAddress, error) {
+	obj, err := objMgr.GetFixedAddressByRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	if obj.MatchClient != "RESERVED" {
+		return nil, fmt.Errorf("fixed address %s is not a reservation, its match_client is %s", obj.IPAddress, obj.MatchClient)
+	}
+	return obj, nil
+}
+
+type resourceDHCPReservationIDStringInterface interface {
+	Id() string
+}
+
+func resourceDHCPReservationIDString(d resourceDHCPReservationIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_dhcp_reservation (ID = %s)", id)
+}
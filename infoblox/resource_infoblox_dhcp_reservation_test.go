@@ -0,0 +1,166 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceDHCPReservation(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDHCPReservationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceDHCPReservationCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPReservationExists(t, "infoblox_dhcp_reservation.foo"),
+					testAccDHCPReservationExists(t, "infoblox_dhcp_reservation.allocated"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_reservation.foo", "ip_addr", "10.30.0.10"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_reservation.foo", "cidr", "10.30.0.0/24"),
+					resource.TestCheckResourceAttrSet("infoblox_dhcp_reservation.allocated", "ip_addr"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceDHCPReservationUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPReservationExists(t, "infoblox_dhcp_reservation.foo"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_reservation.foo", "name", "scanner"),
+					resource.TestCheckResourceAttr("infoblox_dhcp_reservation.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_dhcp_reservation.foo",
+				ImportState:       true,
+				ImportStateId:     "default/10.30.0.10",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDHCPReservationMapping(t *testing.T) {
+	const ref = "fixedaddress/ZG5z:10.0.0.10/default"
+	attributes := map[string]string{
+		"network_view_name": "default",
+		"cidr":              "10.0.0.0/24",
+		"ip_addr":           "10.0.0.10",
+		"name":              "printer",
+		"tenant_id":         "foo",
+	}
+
+	// The name is updated in place.
+	d := testResourceDataUpdate(t, resourceDHCPReservation(), attributes, map[string]interface{}{
+		"ip_addr":   "10.0.0.10",
+		"name":      "scanner",
+		"tenant_id": "foo",
+	})
+	d.SetId(ref)
+	connector, requestor := testConnector(
+		`"fixedaddress/ZG5z:10.0.0.10/default"`,
+		`{"_ref": "fixedaddress/ZG5z:10.0.0.10/default", "network_view": "default", "network": "10.0.0.0/24",
+		  "ipv4addr": "10.0.0.10", "name": "scanner", "match_client": "RESERVED",
+		  "extattrs": {"Tenant ID": {"value": "foo"}}}`,
+	)
+	if err := resourceDHCPReservationUpdate(d, connector); err != nil {
+		t.Fatalf("resourceDHCPReservationUpdate returned error %v", err)
+	}
+	if req := requestor.requests[0]; req.method != "PUT" || req.object(t)["name"] != "scanner" {
+		t.Fatalf("update sent %s %s", req.method, req.body)
+	}
+	if d.Get("name") != "scanner" {
+		t.Fatalf("state has name %v after the update", d.Get("name"))
+	}
+
+	// Other fixed addresses are neither read nor imported.
+	fixedAddr := `{"_ref": "fixedaddress/ZG5z:10.0.0.10/default", "network_view": "default", "network": "10.0.0.0/24",
+	  "ipv4addr": "10.0.0.10", "mac": "00:11:22:33:44:55", "match_client": "MAC_ADDRESS"}`
+	connector, _ = testConnector(fixedAddr)
+	if err := resourceDHCPReservationRead(d, connector); err == nil {
+		t.Fatalf("resourceDHCPReservationRead returned no error for a MAC_ADDRESS fixed address")
+	}
+	connector, _ = testConnector(fixedAddr)
+	if _, err := resourceDHCPReservationImport(d, connector); err == nil {
+		t.Fatalf("resourceDHCPReservationImport returned no error for a MAC_ADDRESS fixed address")
+	}
+}
+
+func testAccCheckDHCPReservationDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dhcp_reservation" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetFixedAddressByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DHCP reservation still exists")
+		}
+	}
+	return nil
+}
+
+func testAccDHCPReservationExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		obj, err := objMgr.GetFixedAddressByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("DHCP reservation not found: %s", err)
+		}
+		if obj.MatchClient != "RESERVED" {
+			return fmt.Errorf("Fixed address %s is not a reservation", obj.IPAddress)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceDHCPReservationCreate = fmt.Sprintf(`
+resource "infoblox_network" "net"{
+	cidr="10.30.0.0/24"
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_reservation" "foo"{
+	ip_addr="10.30.0.10"
+	name="printer"
+	tenant_id="foo"
+	depends_on=[infoblox_network.net]
+	}
+resource "infoblox_dhcp_reservation" "allocated"{
+	cidr=infoblox_network.net.cidr
+	tenant_id="foo"
+	}`)
+
+var testAccresourceDHCPReservationUpdate = fmt.Sprintf(`
+resource "infoblox_network" "net"{
+	cidr="10.30.0.0/24"
+	tenant_id="foo"
+	}
+resource "infoblox_dhcp_reservation" "foo"{
+	ip_addr="10.30.0.10"
+	name="scanner"
+	comment="updated in place"
+	tenant_id="foo"
+	depends_on=[infoblox_network.net]
+	}
+resource "infoblox_dhcp_reservation" "allocated"{
+	cidr=infoblox_network.net.cidr
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,257 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSViewCreate,
+		Read:   resourceDNSViewRead,
+		Update: resourceDNSViewUpdate,
+		Delete: resourceDNSViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSViewImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the DNS view.",
+			},
+			"network_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Network view the DNS view is associated with.",
+			},
+			"recursion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether recursive queries are answered in the DNS view.",
+			},
+			"match_clients": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        addressACResource(),
+				Description: "Clients whose queries are answered from the DNS view. All clients match when empty.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the DNS view.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the DNS view.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func addressACResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An IP address, a network in cidr format or Any.",
+			},
+			"permission": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALLOW",
+				ValidateFunc: validateACPermission,
+				Description:  "Whether the clients are allowed or denied: ALLOW or DENY.",
+			},
+		},
+	}
+}
+
+func validateACPermission(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "ALLOW", "DENY":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of ALLOW or DENY, got %q", k, v.(string)))
+	}
+	return
+}
+
+// addressACs converts a match_clients list to address access control items.
+func addressACs(items []interface{}) []ibclient.AddressAC {
+	res := []ibclient.AddressAC{}
+	for _, v := range items {
+		item := v.(map[string]interface{})
+		res = append(res, ibclient.AddressAC{
+			Address:    item["address"].(string),
+			Permission: item["permission"].(string),
+		})
+	}
+	return res
+}
+
+// addressACsForState converts address access control items returned by
+// NIOS to a match_clients list. Items without an address, such as TSIG keys,
+// are left out.
+func addressACsForState(items *[]ibclient.AddressAC) []interface{} {
+	res := make([]interface{}, 0)
+	if items == nil {
+		return res
+	}
+	for _, item := range *items {
+		if item.Address == "" {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"address":    item.Address,
+			"permission": item.Permission,
+		})
+	}
+	return res
+}
+
+// buildDNSView returns the DNS view described by the arguments in d.
+func buildDNSView(d *schema.ResourceData) ibclient.View {
+	recursion := d.Get("recursion").(bool)
+	matchClients := addressACs(d.Get("match_clients").([]interface{}))
+	comment := d.Get("comment").(string)
+
+	return ibclient.View{
+		Name:         d.Get("name").(string),
+		NetworkView:  d.Get("network_view").(string),
+		Recursion:    &recursion,
+		MatchClients: &matchClients,
+		Comment:      &comment,
+		Ea:           eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceDNSViewCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create DNS view", resourceDNSViewIDString(d))
+
+	name := d.Get("name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	view, err := objMgr.CreateView(buildDNSView(d))
+	if err != nil {
+		return fmt.Errorf("Creation of DNS view (%s) failed : %s", name, err)
+	}
+	d.SetId(view.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of DNS view complete", resourceDNSViewIDString(d))
+	return resourceDNSViewRead(d, m)
+}
+
+func resourceDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required DNS view", resourceDNSViewIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetViewByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: DNS view not found, removing it from state", resourceDNSViewIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting DNS view (%s) failed : %s", d.Id(), err)
+	}
+	d.Set("name", obj.Name)
+	d.Set("network_view", obj.NetworkView)
+	d.Set("recursion", boolValue(obj.Recursion))
+	if err := d.Set("match_clients", addressACsForState(obj.MatchClients)); err != nil {
+		return err
+	}
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading DNS view", resourceDNSViewIDString(d))
+	return nil
+}
+
+func resourceDNSViewUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of DNS view", resourceDNSViewIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	// The reference of the DNS view changes when it is renamed.
+	view, err := objMgr.UpdateView(d.Id(), buildDNSView(d))
+	if err != nil {
+		return fmt.Errorf("Update of DNS view (%s) failed : %s", d.Id(), err)
+	}
+	d.SetId(view.Ref)
+
+	log.Printf("[DEBUG] %s: Update of DNS view complete", resourceDNSViewIDString(d))
+	return resourceDNSViewRead(d, m)
+}
+
+func resourceDNSViewDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of DNS view", resourceDNSViewIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteView(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of DNS view (%s) failed : %s", d.Id(), err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of DNS view complete", resourceDNSViewIDString(d))
+	return nil
+}
+
+// resourceDNSViewImport accepts either a WAPI reference or the name of the
+// DNS view as the import ID.
+func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "view") {
+		ref, err := searchObjectRef(connector, ibclient.NewView(ibclient.View{Name: d.Id()}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceDNSViewIDStringInterface interface {
+	Id() string
+}
+
+func resourceDNSViewIDString(d resourceDNSViewIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_dns_view (ID = %s)", id)
+}
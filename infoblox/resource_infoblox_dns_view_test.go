@@ -0,0 +1,127 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceDNSView(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceDNSViewCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNSViewExists(t, "infoblox_dns_view.foo"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "name", "internal"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "network_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "recursion", "true"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "match_clients.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "match_clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "match_clients.0.permission", "ALLOW"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceDNSViewUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNSViewExists(t, "infoblox_dns_view.foo"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "recursion", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "match_clients.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "match_clients.0.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.foo", "comment", "split horizon"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_dns_view.foo",
+				ImportState:       true,
+				ImportStateId:     "internal",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateACPermission(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "DENY",
+			f:   validateACPermission,
+		},
+		{
+			val:         "allow",
+			f:           validateACPermission,
+			expectedErr: regexp.MustCompile("must be one of ALLOW or DENY"),
+		},
+	})
+}
+
+func testAccCheckDNSViewDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dns_view" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetViewByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DNS view still exists")
+		}
+	}
+	return nil
+}
+
+func testAccDNSViewExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetViewByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("DNS view not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceDNSViewCreate = fmt.Sprintf(`
+resource "infoblox_dns_view" "foo"{
+	name="internal"
+	recursion=true
+	match_clients {
+		address="10.0.0.0/8"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceDNSViewUpdate = fmt.Sprintf(`
+resource "infoblox_dns_view" "foo"{
+	name="internal"
+	match_clients {
+		address="10.0.99.0/24"
+		permission="DENY"
+	}
+	match_clients {
+		address="10.0.0.0/8"
+	}
+	comment="split horizon"
+	tenant_id="foo"
+	}`)
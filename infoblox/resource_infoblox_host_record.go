@@ -0,0 +1,454 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceHostRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostRecordCreate,
+		Read:   resourceHostRecordGet,
+		Update: resourceHostRecordUpdate,
+		Delete: resourceHostRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostRecordImport,
+		},
+		CustomizeDiff: resourceHostRecordCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Fully qualified domain name of the host record.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name of NIOS server.",
+			},
+			"configure_for_dns": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the host record is associated with a DNS zone.",
+			},
+			"ipv4addrs": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IPv4 addresses of the host record.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addr": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "IPv4 address. For dynamic allocation, leave this field empty and set the cidr field.",
+						},
+						"cidr": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network to allocate IP address when the ip_addr field is empty. Network address in cidr format.",
+						},
+						"mac_addr": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "MAC address of the interface.",
+							DiffSuppressFunc: suppressMacAddrDiff,
+						},
+						"configure_for_dhcp": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether DHCP is configured for the address.",
+						},
+					},
+				},
+			},
+			"ipv6addrs": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IPv6 addresses of the host record.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addr": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "IPv6 address. For dynamic allocation, leave this field empty and set the cidr field.",
+						},
+						"cidr": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network to allocate IP address when the ip_addr field is empty. Network address in cidr format.",
+						},
+						"duid": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "DHCPv6 unique identifier of the interface.",
+						},
+						"configure_for_dhcp": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether DHCP is configured for the address.",
+						},
+					},
+				},
+			},
+			"aliases": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alias names of the host record.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the host record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the host record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the host record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the host record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+// resourceHostRecordCustomizeDiff replaces the host record when all of its
+// IPv4 addresses are removed, as an update cannot send an empty ipv4addrs list.
+// It is also replaced when the cidr of an address changes and the address is
+// not in the new network, as ip_addr keeps the address allocated before.
+func resourceHostRecordCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	oldAddrs, newAddrs := d.GetChange("ipv4addrs")
+	if len(oldAddrs.([]interface{})) > 0 && len(newAddrs.([]interface{})) == 0 {
+		return d.ForceNew("ipv4addrs")
+	}
+	for _, key := range []string{"ipv4addrs", "ipv6addrs"} {
+		for i := range d.Get(key).([]interface{}) {
+			cidrKey := fmt.Sprintf("%s.%d.cidr", key, i)
+			cidr := d.Get(cidrKey).(string)
+			ipAddr := d.Get(fmt.Sprintf("%s.%d.ip_addr", key, i)).(string)
+			if d.HasChange(cidrKey) && cidr != "" && ipAddr != "" && !ipAddrInCidr(ipAddr, cidr) {
+				if err := d.ForceNew(cidrKey); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// buildHostRecord returns the host record described by the configuration.
+// Addresses without ip_addr are allocated from their cidr.
+func buildHostRecord(d *schema.ResourceData) (ibclient.HostRecord, error) {
+	networkViewName := d.Get("network_view_name").(string)
+
+	var ipv4Addrs []ibclient.HostRecordIpv4Addr
+	for _, v := range d.Get("ipv4addrs").([]interface{}) {
+		addr := v.(map[string]interface{})
+		ipAddr, err := hostRecordAddr(addr, networkViewName)
+		if err != nil {
+			return ibclient.HostRecord{}, err
+		}
+		enableDHCP := addr["configure_for_dhcp"].(bool)
+		ipv4Addrs = append(ipv4Addrs, ibclient.HostRecordIpv4Addr{
+			Ipv4Addr:   ipAddr,
+			Mac:        addr["mac_addr"].(string),
+			EnableDhcp: &enableDHCP,
+		})
+	}
+
+	ipv6Addrs := []ibclient.HostRecordIpv6Addr{}
+	for _, v := range d.Get("ipv6addrs").([]interface{}) {
+		addr := v.(map[string]interface{})
+		ipAddr, err := hostRecordAddr(addr, networkViewName)
+		if err != nil {
+			return ibclient.HostRecord{}, err
+		}
+		enableDHCP := addr["configure_for_dhcp"].(bool)
+		ipv6Addrs = append(ipv6Addrs, ibclient.HostRecordIpv6Addr{
+			Ipv6Addr:   ipAddr,
+			Duid:       addr["duid"].(string),
+			EnableDhcp: &enableDHCP,
+		})
+	}
+
+	if len(ipv4Addrs) == 0 && len(ipv6Addrs) == 0 {
+		return ibclient.HostRecord{}, fmt.Errorf("at least one ipv4addrs or ipv6addrs block is required")
+	}
+
+	aliases := []string{}
+	for _, alias := range d.Get("aliases").([]interface{}) {
+		aliases = append(aliases, alias.(string))
+	}
+
+	enableDNS := d.Get("configure_for_dns").(bool)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.HostRecord{
+		Name:      d.Get("fqdn").(string),
+		EnableDns: &enableDNS,
+		Ipv4Addrs: ipv4Addrs,
+		Ipv6Addrs: &ipv6Addrs,
+		Aliases:   &aliases,
+		Ttl:       ttl,
+		UseTtl:    useTTL,
+		Comment:   &comment,
+		Disable:   &disable,
+		Ea:        eaFromExtAttrs(d.Get("ext_attrs")),
+	}, nil
+}
+
+// hostRecordAddr returns the address to send for an ipv4addrs or ipv6addrs
+// block, using the next available IP function of NIOS when ip_addr is empty.
+func hostRecordAddr(addr map[string]interface{}, networkViewName string) (string, error) {
+	ipAddr := addr["ip_addr"].(string)
+	cidr := addr["cidr"].(string)
+	if ipAddr != "" {
+		return ipAddr, nil
+	}
+	if cidr == "" {
+		return "", fmt.Errorf("neither ip_addr nor cidr value provided for a host record address")
+	}
+	return fmt.Sprintf("func:nextavailableip:%s,%s", cidr, networkViewName), nil
+}
+
+func resourceHostRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create host record", resourceHostRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	host, err := buildHostRecord(d)
+	if err != nil {
+		return fmt.Errorf("Error creating host record: %s", err)
+	}
+	host.View = dnsView
+	host.NetworkView = networkViewName
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordHost, err := objMgr.CreateHostRecordObject(host)
+	if err != nil {
+		return fmt.Errorf("Error creating host record (%s) in dns view (%s): %s", host.Name, dnsView, err)
+	}
+	d.SetId(recordHost.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of host record complete", resourceHostRecordIDString(d))
+	return resourceHostRecordGet(d, m)
+}
+
+func resourceHostRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get host record", resourceHostRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetHostRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: Host record not found, removing it from state", resourceHostRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting host record failed from dns view (%s) : %s", dnsView, err)
+	}
+
+	d.Set("fqdn", obj.Name)
+	d.Set("dns_view", obj.View)
+	d.Set("network_view_name", obj.NetworkView)
+	if obj.EnableDns != nil {
+		d.Set("configure_for_dns", *obj.EnableDns)
+	}
+
+	// NIOS does not return the network an address was allocated from,
+	// so cidr is kept from the state.
+	oldIpv4Addrs := hostRecordStateAddrs(d.Get("ipv4addrs").([]interface{}))
+	ipv4Addrs := make([]interface{}, 0, len(obj.Ipv4Addrs))
+	for _, addr := range obj.Ipv4Addrs {
+		ipv4Addrs = append(ipv4Addrs, map[string]interface{}{
+			"ip_addr":            addr.Ipv4Addr,
+			"cidr":               oldIpv4Addrs.cidr(addr.Ipv4Addr),
+			"mac_addr":           addr.Mac,
+			"configure_for_dhcp": addr.EnableDhcp != nil && *addr.EnableDhcp,
+		})
+	}
+	if err := d.Set("ipv4addrs", ipv4Addrs); err != nil {
+		return err
+	}
+
+	oldIpv6Addrs := hostRecordStateAddrs(d.Get("ipv6addrs").([]interface{}))
+	ipv6Addrs := make([]interface{}, 0)
+	if obj.Ipv6Addrs != nil {
+		for _, addr := range *obj.Ipv6Addrs {
+			ipv6Addrs = append(ipv6Addrs, map[string]interface{}{
+				"ip_addr":            addr.Ipv6Addr,
+				"cidr":               oldIpv6Addrs.cidr(addr.Ipv6Addr),
+				"duid":               addr.Duid,
+				"configure_for_dhcp": addr.EnableDhcp != nil && *addr.EnableDhcp,
+			})
+		}
+	}
+	if err := d.Set("ipv6addrs", ipv6Addrs); err != nil {
+		return err
+	}
+
+	if obj.Aliases != nil {
+		d.Set("aliases", *obj.Aliases)
+	} else {
+		d.Set("aliases", nil)
+	}
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required host record ", resourceHostRecordIDString(d))
+	return nil
+}
+
+// hostRecordStateAddrs holds the address blocks in state that have not been
+// matched to an address of the host record yet.
+type hostRecordStateAddrs []interface{}
+
+// cidr returns the cidr of the address block in state for ipAddr and removes
+// the block from addrs. The block with the same ip_addr is used first, then a
+// block without ip_addr whose cidr contains ipAddr, as allocated addresses
+// are only known after create.
+func (addrs *hostRecordStateAddrs) cidr(ipAddr string) string {
+	match := -1
+	for i, v := range *addrs {
+		if v == nil {
+			continue
+		}
+		addr := v.(map[string]interface{})
+		if addr["ip_addr"].(string) == ipAddr {
+			match = i
+			break
+		}
+		if match < 0 && addr["ip_addr"].(string) == "" && ipAddrInCidr(ipAddr, addr["cidr"].(string)) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return ""
+	}
+	cidr := (*addrs)[match].(map[string]interface{})["cidr"].(string)
+	*addrs = append((*addrs)[:match:match], (*addrs)[match+1:]...)
+	return cidr
+}
+
+func resourceHostRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of host record", resourceHostRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	host, err := buildHostRecord(d)
+	if err != nil {
+		return fmt.Errorf("Updating host record failed: %s", err)
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err = objMgr.UpdateHostRecordObject(d.Id(), host)
+	if err != nil {
+		return fmt.Errorf("Updating host record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of host record complete", resourceHostRecordIDString(d))
+	return resourceHostRecordGet(d, m)
+}
+
+func resourceHostRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of host record", resourceHostRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteHostRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of host record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of host record complete", resourceHostRecordIDString(d))
+	return nil
+}
+
+// resourceHostRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceHostRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:host") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewHostRecord(ibclient.HostRecord{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceHostRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceHostRecordIDString(d resourceHostRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_host_record (ID = %s)", id)
+}
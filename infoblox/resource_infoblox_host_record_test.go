@@ -0,0 +1,185 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestHostRecordStateAddrs(t *testing.T) {
+	addrs := hostRecordStateAddrs{
+		map[string]interface{}{"ip_addr": "", "cidr": "10.0.0.0/24"},
+		map[string]interface{}{"ip_addr": "10.0.1.5", "cidr": "10.0.1.0/24"},
+		map[string]interface{}{"ip_addr": "10.0.0.7", "cidr": ""},
+	}
+
+	cases := []struct {
+		ipAddr   string
+		expected string
+	}{
+		{"10.0.1.5", "10.0.1.0/24"},
+		{"10.0.0.7", ""},
+		{"10.0.0.9", "10.0.0.0/24"},
+		{"10.0.0.10", ""},
+	}
+
+	for _, tc := range cases {
+		if cidr := addrs.cidr(tc.ipAddr); cidr != tc.expected {
+			t.Fatalf("cidr(%s) returned %q, expected %q", tc.ipAddr, cidr, tc.expected)
+		}
+	}
+	if len(addrs) != 0 {
+		t.Fatalf("cidr left unmatched address blocks %v", addrs)
+	}
+}
+
+func TestHostRecordCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "record:host/ZG5z:host.a.com/default",
+		Attributes: map[string]string{
+			"fqdn":                           "host.a.com",
+			"dns_view":                       "default",
+			"network_view_name":              "default",
+			"ipv4addrs.#":                    "1",
+			"ipv4addrs.0.ip_addr":            "10.0.0.5",
+			"ipv4addrs.0.cidr":               "10.0.0.0/24",
+			"ipv4addrs.0.configure_for_dhcp": "false",
+		},
+	}
+
+	cases := []struct {
+		addr       map[string]interface{}
+		requireNew bool
+	}{
+		{map[string]interface{}{"cidr": "10.0.0.0/24"}, false},
+		{map[string]interface{}{"cidr": "10.0.0.0/16"}, false},
+		{map[string]interface{}{"cidr": "10.0.1.0/24"}, true},
+		{map[string]interface{}{"cidr": "10.0.1.0/24", "ip_addr": "10.0.1.5"}, false},
+	}
+
+	r := resourceHostRecord()
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"fqdn":      "host.a.com",
+			"ipv4addrs": []interface{}{tc.addr},
+		}
+		diff, err := r.Diff(state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("Diff(%v) returned error %v", tc.addr, err)
+		}
+		if diff.RequiresNew() != tc.requireNew {
+			t.Fatalf("Diff(%v) requires new %t, expected %t", tc.addr, diff.RequiresNew(), tc.requireNew)
+		}
+	}
+}
+
+func TestAccResourceHostRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceHostRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordExists(t, "infoblox_host_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "fqdn", "test-host.a.com"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv4addrs.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv4addrs.0.ip_addr", "10.0.0.10"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "aliases.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceHostRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordExists(t, "infoblox_host_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv4addrs.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv4addrs.0.ip_addr", "10.0.0.10"),
+					resource.TestCheckResourceAttrSet("infoblox_host_record.foo", "ipv4addrs.1.ip_addr"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv6addrs.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv6addrs.0.ip_addr", "2001:db8::10"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ttl", "3600"),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_host_record.foo",
+				ImportState:             true,
+				ImportStateId:           "default/test-host.a.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4addrs.1.cidr"},
+			},
+		},
+	})
+}
+
+func testAccCheckHostRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_host_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetHostRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("host record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccHostRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetHostRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("host record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceHostRecordCreate = fmt.Sprintf(`
+resource "infoblox_host_record" "foo"{
+	fqdn="test-host.a.com"
+	ipv4addrs {
+		ip_addr="10.0.0.10"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceHostRecordUpdate = fmt.Sprintf(`
+resource "infoblox_host_record" "foo"{
+	fqdn="test-host.a.com"
+	ipv4addrs {
+		ip_addr="10.0.0.10"
+	}
+	ipv4addrs {
+		cidr="10.0.0.0/24"
+	}
+	ipv6addrs {
+		ip_addr="2001:db8::10"
+	}
+	aliases=["alias-host.a.com"]
+	ttl=3600
+	comment="updated in place"
+	tenant_id="foo"
+	}`)
@@ -13,6 +13,9 @@ func resourceIPAllocation() *schema.Resource {
 		Read:   resourceIPAllocationGet,
 		Update: resourceIPAllocationUpdate,
 		Delete: resourceIPAllocationRelease,
+		Importer: &schema.ResourceImporter{
+			State: resourceIPAllocationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_view_name": &schema.Schema{
@@ -54,15 +57,51 @@ func resourceIPAllocation() *schema.Resource {
 				Computed:    true,
 			},
 			"mac_addr": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "mac address of your instance in cloud.",
+				DiffSuppressFunc: suppressMacAddrDiff,
+			},
+			"match_client": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MAC_ADDRESS",
+				ValidateFunc: validateMatchClient,
+				Description:  "How DHCP clients are matched to the fixed address: MAC_ADDRESS, CLIENT_ID, CIRCUIT_ID, REMOTE_ID or RESERVED. Host records only support MAC_ADDRESS.",
+			},
+			"dhcp_client_identifier": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DHCP client identifier of the client, used when match_client is CLIENT_ID.",
+			},
+			"agent_circuit_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "mac address of your instance in cloud.",
+				Description: "Circuit ID the DHCP relay agent adds for the client, used when match_client is CIRCUIT_ID.",
+			},
+			"agent_remote_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Remote ID the DHCP relay agent adds for the client, used when match_client is REMOTE_ID.",
 			},
 			"vm_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "instance id.",
 			},
+			"dhcp_option": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionResource(),
+				Description: "DHCP options sent to the client of the fixed address. Not supported for host records.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the fixed address or host record.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -88,12 +127,13 @@ func resourceIPAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	zone := d.Get("zone").(string)
 	enableDns := d.Get("enable_dns").(bool)
 	dnsView := d.Get("dns_view").(string)
+	matchClient := d.Get("match_client").(string)
 
 	connector := m.(*ibclient.Connector)
 	ZeroMacAddr := "00:00:00:00:00:00"
 	//fqdn
 	name := recordName + "." + zone
-	ea := make(ibclient.EA)
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
 	if vmName != "" {
 		ea["VM Name"] = vmName
 	}
@@ -104,8 +144,15 @@ func resourceIPAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		macAddr = ZeroMacAddr
 	}
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+	options := dhcpOptions(d.Get("dhcp_option").([]interface{}))
 
 	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
+		if len(options) > 0 {
+			return fmt.Errorf("Error allocating IP from network block(%s): dhcp_option is not supported for host records", cidr)
+		}
+		if matchClient != "MAC_ADDRESS" {
+			return fmt.Errorf("Error allocating IP from network block(%s): match_client %s is not supported for host records", cidr, matchClient)
+		}
 		hostAddressObj, err := objMgr.CreateHostRecord(enableDns, name, networkViewName, dnsView, cidr, ipAddr, macAddr, ea)
 		if err != nil {
 			return fmt.Errorf("Error allocating IP from network block(%s): %s", cidr, err)
@@ -113,7 +160,26 @@ func resourceIPAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		d.Set("ip_addr", hostAddressObj.Ipv4Addrs[0].Ipv4Addr)
 		d.SetId(hostAddressObj.Ref)
 	} else {
-		fixedAddressObj, err := objMgr.AllocateIP(networkViewName, cidr, ipAddr, macAddr, recordName, ea)
+		identifier, err := matchClientIdentifier(d)
+		if err != nil {
+			return fmt.Errorf("Error allocating IP from network block(%s): %s", cidr, err)
+		}
+		fixedAddr := ibclient.FixedAddress{
+			NetviewName: networkViewName,
+			Cidr:        cidr,
+			IPAddress:   ipAddr,
+			Mac:         macAddr,
+			MatchClient: matchClient,
+			Ea:          ea,
+		}
+		fixedAddr.SetClientIdentifier(matchClient, identifier)
+		if recordName != "" {
+			fixedAddr.Name = &recordName
+		}
+		if len(options) > 0 {
+			fixedAddr.Options = &options
+		}
+		fixedAddressObj, err := objMgr.CreateFixedAddress(fixedAddr)
 		if err != nil {
 			return fmt.Errorf("Error allocating IP from network block(%s): %s", cidr, err)
 		}
@@ -130,23 +196,64 @@ func resourceIPAllocationGet(d *schema.ResourceData, m interface{}) error {
 
 	tenantID := d.Get("tenant_id").(string)
 	cidr := d.Get("cidr").(string)
-	zone := d.Get("zone").(string)
-	dnsView := d.Get("dns_view").(string)
 	connector := m.(*ibclient.Connector)
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
+	if isWapiRef(d.Id(), "record:host") {
 		obj, err := objMgr.GetHostRecordByRef(d.Id())
 		if err != nil {
+			if isNotFoundError(err) {
+				log.Printf("[WARN] %s: Host Record not found, removing it from state", resourceIPAllocationIDString(d))
+				d.SetId("")
+				return nil
+			}
 			return fmt.Errorf("Error getting IP from network block(%s): %s", cidr, err)
 		}
+		if len(obj.Ipv4Addrs) > 0 {
+			d.Set("ip_addr", obj.Ipv4Addrs[0].Ipv4Addr)
+			d.Set("mac_addr", macAddrForState(d, obj.Ipv4Addrs[0].Mac))
+		}
+		if obj.NetworkView != "" {
+			d.Set("network_view_name", obj.NetworkView)
+		}
+		d.Set("enable_dns", boolValue(obj.EnableDns))
+		d.Set("zone", obj.Zone)
+		d.Set("dns_view", obj.View)
+		d.Set("match_client", "MAC_ADDRESS")
+		d.Set("vm_name", getEAValue(obj.Ea, "VM Name"))
+		d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+		d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+		setTenantID(d, obj.Ea)
+
 		d.SetId(obj.Ref)
 	} else {
 		obj, err := objMgr.GetFixedAddressByRef(d.Id())
 		if err != nil {
+			if isNotFoundError(err) {
+				log.Printf("[WARN] %s: Fixed Address not found, removing it from state", resourceIPAllocationIDString(d))
+				d.SetId("")
+				return nil
+			}
 			return fmt.Errorf("Error getting IP from network block(%s): %s", cidr, err)
 		}
+		d.Set("ip_addr", obj.IPAddress)
+		d.Set("cidr", obj.Cidr)
+		d.Set("network_view_name", obj.NetviewName)
+		d.Set("enable_dns", false)
+		d.Set("mac_addr", macAddrForState(d, obj.Mac))
+		d.Set("match_client", obj.MatchClient)
+		d.Set("dhcp_client_identifier", obj.DhcpClientIdentifier)
+		d.Set("agent_circuit_id", obj.AgentCircuitId)
+		d.Set("agent_remote_id", obj.AgentRemoteId)
+		if err := d.Set("dhcp_option", dhcpOptionsForState(d.Get("dhcp_option").([]interface{}), obj.Options)); err != nil {
+			return err
+		}
+		d.Set("vm_name", getEAValue(obj.Ea, "VM Name"))
+		d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+		d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+		setTenantID(d, obj.Ea)
+
 		d.SetId(obj.Ref)
 	}
 	log.Printf("[DEBUG] %s: Completed Reading IP from the network block", resourceIPAllocationIDString(d))
@@ -155,34 +262,49 @@ func resourceIPAllocationGet(d *schema.ResourceData, m interface{}) error {
 
 func resourceIPAllocationUpdate(d *schema.ResourceData, m interface{}) error {
 
-	match_client := "MAC_ADDRESS"
-
 	log.Printf("[DEBUG] %s: Updating the Parameters of the allocated IP in the specified network block", resourceIPAllocationIDString(d))
 
 	macAddr := d.Get("mac_addr").(string)
 	tenantID := d.Get("tenant_id").(string)
-	vmID := d.Get("vm_id").(string)
-	vmName := d.Get("vm_name").(string)
 	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
+	matchClient := d.Get("match_client").(string)
+	addEA, removeEA := vmExtAttrsChange(d)
 	connector := m.(*ibclient.Connector)
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
+		if len(d.Get("dhcp_option").([]interface{})) > 0 {
+			return fmt.Errorf("Error updating IP from network block having reference (%s): dhcp_option is not supported for host records", d.Id())
+		}
+		if matchClient != "MAC_ADDRESS" {
+			return fmt.Errorf("Error updating IP from network block having reference (%s): match_client %s is not supported for host records", d.Id(), matchClient)
+		}
 		hostRecordObj, _ := objMgr.GetHostRecordByRef(d.Id())
 		IPAddrObj, _ := objMgr.GetIpAddressFromHostRecord(*hostRecordObj)
-		obj, err := objMgr.UpdateHostRecord(d.Id(), IPAddrObj, macAddr, vmID, vmName)
+		obj, err := objMgr.UpdateHostRecord(d.Id(), IPAddrObj, macAddr, addEA, removeEA)
 		if err != nil {
 			return fmt.Errorf("Error updating IP from network block having reference (%s): %s", d.Id(), err)
 		}
 		d.SetId(obj)
 	} else {
-		obj, err := objMgr.UpdateFixedAddress(d.Id(), match_client, macAddr, vmID, vmName)
+		identifier, err := matchClientIdentifier(d)
+		if err != nil {
+			return fmt.Errorf("Error updating IP from network block having reference (%s): %s", d.Id(), err)
+		}
+		obj, err := objMgr.UpdateFixedAddress(d.Id(), matchClient, macAddr, identifier, addEA, removeEA)
 		if err != nil {
 			return fmt.Errorf("Error updating IP from network block having reference (%s): %s", d.Id(), err)
 		}
 		d.SetId(obj.Ref)
+		if d.HasChange("dhcp_option") {
+			obj, err = objMgr.UpdateFixedAddressOptions(d.Id(), dhcpOptions(d.Get("dhcp_option").([]interface{})))
+			if err != nil {
+				return fmt.Errorf("Error updating DHCP options of IP from network block having reference (%s): %s", d.Id(), err)
+			}
+			d.SetId(obj.Ref)
+		}
 	}
 	log.Printf("[DEBUG] %s: Updation of Parameters of allocated IP complete in the specified network block", resourceIPAllocationIDString(d))
 	return resourceIPAllocationGet(d, m)
@@ -215,6 +337,33 @@ func resourceIPAllocationRelease(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceIPAllocationImport accepts either a WAPI reference of a fixed address or
+// a host record, <network_view>/<ip_addr> for a fixed address or
+// <dns_view>/<fqdn> for a host record as the import ID.
+func resourceIPAllocationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "fixedaddress") && !isWapiRef(d.Id(), "record:host") {
+		view, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		var search ibclient.IBObject
+		if isIPAddress(key) {
+			search = ibclient.NewFixedAddress(ibclient.FixedAddress{NetviewName: view, IPAddress: key})
+		} else {
+			search = ibclient.NewHostRecord(ibclient.HostRecord{View: view, Name: key})
+		}
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceIPAllocationIDStringInterface interface {
 	Id() string
 }
@@ -3,6 +3,7 @@ package infoblox
 import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/infobloxopen/infoblox-go-client"
 	"testing"
@@ -25,12 +26,128 @@ func TestAccResourceIPAllocation(t *testing.T) {
 				Config: testAccresourceIPAllocationUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccIPExists(t, "infoblox_ip_allocation.foo", "10.0.0.1/24", "10.0.0.1", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.foo", "dhcp_option.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.foo", "dhcp_option.0.num", "67"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccresourceIPAllocationMatchClient,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIPExists(t, "infoblox_ip_allocation.foo", "10.0.0.1/24", "10.0.0.1", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.foo", "match_client", "CLIENT_ID"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.foo", "dhcp_client_identifier", "01:11:22:33:44:55:66"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIPAllocationHostRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPAllocationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceIPAllocationHostRecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.host", "enable_dns", "true"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.host", "ip_addr", "10.0.0.5"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_ip_allocation.host",
+				ImportState:             true,
+				ImportStateId:           "default/test-host.a.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr"},
+			},
 		},
 	})
 }
 
+func TestIPAllocationMatchClientMapping(t *testing.T) {
+	const ref = "fixedaddress/ZG5z:10.0.0.5/default"
+
+	// A client identifier is required by match_client CLIENT_ID.
+	d := schema.TestResourceDataRaw(t, resourceIPAllocation().Schema, map[string]interface{}{
+		"cidr":         "10.0.0.0/24",
+		"vm_name":      "web",
+		"match_client": "CLIENT_ID",
+		"tenant_id":    "foo",
+	})
+	connector, requestor := testConnector()
+	if err := resourceIPAllocationRequest(d, connector); err == nil || len(requestor.requests) != 0 {
+		t.Fatalf("resourceIPAllocationRequest returned error %v after %d requests, expected dhcp_client_identifier to be required", err, len(requestor.requests))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceIPAllocation().Schema, map[string]interface{}{
+		"cidr":                   "10.0.0.0/24",
+		"vm_name":                "web",
+		"match_client":           "CLIENT_ID",
+		"dhcp_client_identifier": "01:aa:bb:cc:dd:ee:ff",
+		"tenant_id":              "foo",
+	})
+	connector, requestor = testConnector(
+		`"fixedaddress/ZG5z:10.0.0.5/default"`,
+		`{"_ref": "fixedaddress/ZG5z:10.0.0.5/default", "network_view": "default", "network": "10.0.0.0/24",
+		  "ipv4addr": "10.0.0.5", "mac": "00:00:00:00:00:00", "name": "web",
+		  "match_client": "CLIENT_ID", "dhcp_client_identifier": "01:aa:bb:cc:dd:ee:ff",
+		  "extattrs": {"VM Name": {"value": "web"}, "Tenant ID": {"value": "foo"}}}`,
+	)
+	if err := resourceIPAllocationRequest(d, connector); err != nil {
+		t.Fatalf("resourceIPAllocationRequest returned error %v", err)
+	}
+	obj := requestor.requests[0].object(t)
+	if obj["match_client"] != "CLIENT_ID" || obj["dhcp_client_identifier"] != "01:aa:bb:cc:dd:ee:ff" || obj["name"] != "web" {
+		t.Fatalf("create request sent %v", obj)
+	}
+	if d.Get("match_client") != "CLIENT_ID" || d.Get("dhcp_client_identifier") != "01:aa:bb:cc:dd:ee:ff" || d.Get("ip_addr") != "10.0.0.5" {
+		t.Fatalf("state has match_client %v, dhcp_client_identifier %v and ip_addr %v",
+			d.Get("match_client"), d.Get("dhcp_client_identifier"), d.Get("ip_addr"))
+	}
+
+	// Matching the client by its remote ID instead.
+	d = testResourceDataUpdate(t, resourceIPAllocation(), map[string]string{
+		"network_view_name":      "default",
+		"cidr":                   "10.0.0.0/24",
+		"vm_name":                "web",
+		"ip_addr":                "10.0.0.5",
+		"mac_addr":               "00:00:00:00:00:00",
+		"match_client":           "CLIENT_ID",
+		"dhcp_client_identifier": "01:aa:bb:cc:dd:ee:ff",
+		"enable_dns":             "false",
+		"tenant_id":              "foo",
+	}, map[string]interface{}{
+		"cidr":            "10.0.0.0/24",
+		"vm_name":         "web",
+		"match_client":    "REMOTE_ID",
+		"agent_remote_id": "port-7",
+		"tenant_id":       "foo",
+	})
+	d.SetId(ref)
+	connector, requestor = testConnector(
+		`"fixedaddress/ZG5z:10.0.0.5/default"`,
+		`{"_ref": "fixedaddress/ZG5z:10.0.0.5/default", "network_view": "default", "network": "10.0.0.0/24",
+		  "ipv4addr": "10.0.0.5", "mac": "00:00:00:00:00:00", "name": "web",
+		  "match_client": "REMOTE_ID", "agent_remote_id": "port-7",
+		  "extattrs": {"VM Name": {"value": "web"}, "Tenant ID": {"value": "foo"}}}`,
+	)
+	if err := resourceIPAllocationUpdate(d, connector); err != nil {
+		t.Fatalf("resourceIPAllocationUpdate returned error %v", err)
+	}
+	req := requestor.requests[0]
+	obj = req.object(t)
+	if req.method != "PUT" || obj["match_client"] != "REMOTE_ID" || obj["agent_remote_id"] != "port-7" || obj["dhcp_client_identifier"] != nil {
+		t.Fatalf("update sent %s %v", req.method, obj)
+	}
+	if d.Get("match_client") != "REMOTE_ID" || d.Get("agent_remote_id") != "port-7" || d.Get("dhcp_client_identifier") != "" {
+		t.Fatalf("state has match_client %v, agent_remote_id %v and dhcp_client_identifier %v",
+			d.Get("match_client"), d.Get("agent_remote_id"), d.Get("dhcp_client_identifier"))
+	}
+}
+
 func testAccCheckIPAllocationDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 	for _, rs := range s.RootModule().Resources {
@@ -84,5 +201,36 @@ resource "infoblox_ip_allocation" "foo"{
 	vm_name="test-name"
 	cidr="10.0.0.0/24"
 	ip_addr="10.0.0.1"
+	dhcp_option {
+		name="bootfile-name"
+		value="pxelinux.0"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceIPAllocationMatchClient = fmt.Sprintf(`
+resource "infoblox_ip_allocation" "foo"{
+	network_view_name="default"
+	vm_name="test-name"
+	cidr="10.0.0.0/24"
+	ip_addr="10.0.0.1"
+	match_client="CLIENT_ID"
+	dhcp_client_identifier="01:11:22:33:44:55:66"
+	dhcp_option {
+		name="bootfile-name"
+		value="pxelinux.0"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceIPAllocationHostRecord = fmt.Sprintf(`
+resource "infoblox_ip_allocation" "host"{
+	network_view_name="default"
+	vm_name="test-host"
+	cidr="10.0.0.0/24"
+	ip_addr="10.0.0.5"
+	zone="a.com"
+	dns_view="default"
+	enable_dns=true
 	tenant_id="foo"
 	}`)
@@ -15,6 +15,9 @@ func resourceIPAssociation() *schema.Resource {
 		Update: resourceIPAssociationUpdate,
 		Delete: resourceIPAssociationDelete,
 		Read:   resourceIPAssociationRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceIPAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_view_name": &schema.Schema{
@@ -39,9 +42,32 @@ func resourceIPAssociation() *schema.Resource {
 				Description: "IP address your instance in cloud.",
 			},
 			"mac_addr": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "mac address of your instance in cloud. Required when match_client is MAC_ADDRESS.",
+				DiffSuppressFunc: suppressMacAddrDiff,
+			},
+			"match_client": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MAC_ADDRESS",
+				ValidateFunc: validateMatchClient,
+				Description:  "How DHCP clients are matched to the fixed address: MAC_ADDRESS, CLIENT_ID, CIRCUIT_ID, REMOTE_ID or RESERVED. Host records only support MAC_ADDRESS.",
+			},
+			"dhcp_client_identifier": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "mac address of your instance in cloud.",
+				Optional:    true,
+				Description: "DHCP client identifier of the client, used when match_client is CLIENT_ID.",
+			},
+			"agent_circuit_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Circuit ID the DHCP relay agent adds for the client, used when match_client is CIRCUIT_ID.",
+			},
+			"agent_remote_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Remote ID the DHCP relay agent adds for the client, used when match_client is REMOTE_ID.",
 			},
 			"dns_view": &schema.Schema{
 				Type:        schema.TypeString,
@@ -58,6 +84,13 @@ func resourceIPAssociation() *schema.Resource {
 				Optional:    true,
 				Description: "instance id.",
 			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the associated fixed address or host record.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -99,23 +132,59 @@ func resourceIPAssociationRead(d *schema.ResourceData, m interface{}) error {
 
 	tenantID := d.Get("tenant_id").(string)
 	cidr := d.Get("cidr").(string)
-	zone := d.Get("zone").(string)
-	dnsView := d.Get("dns_view").(string)
 	connector := m.(*ibclient.Connector)
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
+	if isWapiRef(d.Id(), "record:host") {
 		obj, err := objMgr.GetHostRecordByRef(d.Id())
 		if err != nil {
+			if isNotFoundError(err) {
+				log.Printf("[WARN] %s: Host Record not found, removing it from state", resourceIPAssociationIDString(d))
+				d.SetId("")
+				return nil
+			}
 			return fmt.Errorf("Error getting IP from network block(%s): %s", cidr, err)
 		}
+		if len(obj.Ipv4Addrs) > 0 {
+			d.Set("ip_addr", obj.Ipv4Addrs[0].Ipv4Addr)
+			d.Set("mac_addr", macAddrForState(d, obj.Ipv4Addrs[0].Mac))
+		}
+		if obj.NetworkView != "" {
+			d.Set("network_view_name", obj.NetworkView)
+		}
+		d.Set("zone", obj.Zone)
+		d.Set("dns_view", obj.View)
+		d.Set("match_client", "MAC_ADDRESS")
+		d.Set("vm_name", getEAValue(obj.Ea, "VM Name"))
+		d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+		d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+		setTenantID(d, obj.Ea)
+
 		d.SetId(obj.Ref)
 	} else {
 		obj, err := objMgr.GetFixedAddressByRef(d.Id())
 		if err != nil {
+			if isNotFoundError(err) {
+				log.Printf("[WARN] %s: Fixed Address not found, removing it from state", resourceIPAssociationIDString(d))
+				d.SetId("")
+				return nil
+			}
 			return fmt.Errorf("Error getting IP from network block(%s): %s", cidr, err)
 		}
+		d.Set("ip_addr", obj.IPAddress)
+		d.Set("cidr", obj.Cidr)
+		d.Set("network_view_name", obj.NetviewName)
+		d.Set("mac_addr", macAddrForState(d, obj.Mac))
+		d.Set("match_client", obj.MatchClient)
+		d.Set("dhcp_client_identifier", obj.DhcpClientIdentifier)
+		d.Set("agent_circuit_id", obj.AgentCircuitId)
+		d.Set("agent_remote_id", obj.AgentRemoteId)
+		d.Set("vm_name", getEAValue(obj.Ea, "VM Name"))
+		d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+		d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+		setTenantID(d, obj.Ea)
+
 		d.SetId(obj.Ref)
 	}
 	log.Printf("[DEBUG] %s: Completed Reading IP from the network block", resourceIPAllocationIDString(d))
@@ -129,8 +198,6 @@ func resourceIPAssociationDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Reassociation of IP address in specified network block", resourceIPAssociationIDString(d))
 	matchClient := "MAC_ADDRESS"
 	ipAddr := d.Get("ip_addr").(string)
-	vmID := d.Get("vm_id").(string)
-	vmName := d.Get("vm_name").(string)
 	tenantID := d.Get("tenant_id").(string)
 	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
@@ -141,13 +208,13 @@ func resourceIPAssociationDelete(d *schema.ResourceData, m interface{}) error {
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
-		_, err := objMgr.UpdateHostRecord(d.Id(), ipAddr, ZeroMacAddr, vmID, vmName)
+		_, err := objMgr.UpdateHostRecord(d.Id(), ipAddr, ZeroMacAddr, nil, nil)
 		if err != nil {
 			return fmt.Errorf("Error Releasing IP from network block having reference (%s): %s", d.Id(), err)
 		}
 		d.SetId("")
 	} else {
-		_, err := objMgr.UpdateFixedAddress(d.Id(), matchClient, ZeroMacAddr, "", "")
+		_, err := objMgr.UpdateFixedAddress(d.Id(), matchClient, ZeroMacAddr, "", nil, nil)
 		if err != nil {
 			return fmt.Errorf("Error Releasing IP from network block having reference (%s): %s", d.Id(), err)
 		}
@@ -158,6 +225,33 @@ func resourceIPAssociationDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceIPAssociationImport accepts either a WAPI reference of a fixed address or
+// a host record, <network_view>/<ip_addr> for a fixed address or
+// <dns_view>/<fqdn> for a host record as the import ID.
+func resourceIPAssociationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "fixedaddress") && !isWapiRef(d.Id(), "record:host") {
+		view, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		var search ibclient.IBObject
+		if isIPAddress(key) {
+			search = ibclient.NewFixedAddress(ibclient.FixedAddress{NetviewName: view, IPAddress: key})
+		} else {
+			search = ibclient.NewHostRecord(ibclient.HostRecord{View: view, Name: key})
+		}
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceIPAssociationIDStringInterface interface {
 	Id() string
 }
@@ -172,16 +266,16 @@ func resourceIPAssociationIDString(d resourceIPAssociationIDStringInterface) str
 
 func Resource(d *schema.ResourceData, m interface{}) error {
 
-	matchClient := "MAC_ADDRESS"
+	matchClient := d.Get("match_client").(string)
 	networkViewName := d.Get("network_view_name").(string)
 	Name := d.Get("vm_name").(string)
 	ipAddr := d.Get("ip_addr").(string)
 	cidr := d.Get("cidr").(string)
 	macAddr := d.Get("mac_addr").(string)
 	tenantID := d.Get("tenant_id").(string)
-	vmID := d.Get("vm_id").(string)
 	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
+	addEA, removeEA := vmExtAttrsChange(d)
 
 	connector := m.(*ibclient.Connector)
 
@@ -190,7 +284,18 @@ func Resource(d *schema.ResourceData, m interface{}) error {
 	macAddr = strings.Replace(macAddr, "-", ":", -1)
 	name := Name + "." + zone
 
+	if matchClient == "MAC_ADDRESS" && macAddr == "" {
+		return fmt.Errorf("mac_addr is required when match_client is MAC_ADDRESS")
+	}
+	identifier, err := matchClientIdentifier(d)
+	if err != nil {
+		return err
+	}
+
 	if (zone != "" || len(zone) != 0) && (dnsView != "" || len(dnsView) != 0) {
+		if matchClient != "MAC_ADDRESS" {
+			return fmt.Errorf("match_client %s is not supported for host records", matchClient)
+		}
 		hostRecordObj, err := objMgr.GetHostRecord(name, networkViewName, cidr, ipAddr)
 		if err != nil {
 			return fmt.Errorf("GetHostRecord failed from network block(%s):%s", cidr, err)
@@ -198,7 +303,7 @@ func Resource(d *schema.ResourceData, m interface{}) error {
 		if hostRecordObj == nil {
 			return fmt.Errorf("HostRecord %s not found.", name)
 		}
-		_, err = objMgr.UpdateHostRecord(hostRecordObj.Ref, ipAddr, macAddr, vmID, Name)
+		_, err = objMgr.UpdateHostRecord(hostRecordObj.Ref, ipAddr, macAddr, addEA, removeEA)
 		if err != nil {
 			return fmt.Errorf("UpdateHost Record error from network block(%s):%s", cidr, err)
 		}
@@ -212,7 +317,7 @@ func Resource(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf("FixedAddress %s not found in network %s.", ipAddr, cidr)
 		}
 
-		_, err = objMgr.UpdateFixedAddress(fixedAddressObj.Ref, matchClient, macAddr, vmID, Name)
+		_, err = objMgr.UpdateFixedAddress(fixedAddressObj.Ref, matchClient, macAddr, identifier, addEA, removeEA)
 		if err != nil {
 			return fmt.Errorf("UpdateFixedAddress error from network block(%s):%s", cidr, err)
 		}
@@ -0,0 +1,220 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceIpv6FixedAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpv6FixedAddressCreate,
+		Read:   resourceIpv6FixedAddressRead,
+		Update: resourceIpv6FixedAddressUpdate,
+		Delete: resourceIpv6FixedAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpv6FixedAddressImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IPv6 network to allocate IP address when the ip_addr field is empty. Network address in cidr format.",
+			},
+			"ip_addr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "IPv6 address of the fixed address. For dynamic allocation, leave this field empty and set the cidr field.",
+			},
+			"duid": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DHCPv6 unique identifier of the client.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the IPv6 fixed address.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the IPv6 fixed address.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the IPv6 fixed address.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceIpv6FixedAddressCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to allocate IPv6 fixed address", resourceIpv6FixedAddressIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	ipAddr := d.Get("ip_addr").(string)
+	duid := d.Get("duid").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	if ipAddr == "" && cidr == "" {
+		return fmt.Errorf("Error allocating IPv6 fixed address: neither ip_addr nor cidr value provided.")
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	fixedAddr, err := objMgr.AllocateIpv6IP(networkViewName, cidr, ipAddr, duid, name, comment, extAttrs)
+	if err != nil {
+		return fmt.Errorf("Error allocating IPv6 fixed address from network block(%s): %s", cidr, err)
+	}
+
+	d.SetId(fixedAddr.Ref)
+
+	log.Printf("[DEBUG] %s: Allocation of IPv6 fixed address complete", resourceIpv6FixedAddressIDString(d))
+	return resourceIpv6FixedAddressRead(d, m)
+}
+
+func resourceIpv6FixedAddressRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to Get IPv6 fixed address", resourceIpv6FixedAddressIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetIpv6FixedAddressByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: IPv6 fixed address not found, removing it from state", resourceIpv6FixedAddressIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting IPv6 fixed address failed from network view (%s) : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("ip_addr", obj.IPv6Address)
+	d.Set("duid", obj.Duid)
+	if obj.Name != nil {
+		d.Set("name", *obj.Name)
+	} else {
+		d.Set("name", "")
+	}
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading IPv6 fixed address", resourceIpv6FixedAddressIDString(d))
+	return nil
+}
+
+func resourceIpv6FixedAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of IPv6 fixed address", resourceIpv6FixedAddressIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+
+	_, err := objMgr.UpdateIpv6FixedAddress(d.Id(), ibclient.Ipv6FixedAddress{
+		Duid:    d.Get("duid").(string),
+		Name:    &name,
+		Comment: &comment,
+	}, addEA, removeEA)
+	if err != nil {
+		return fmt.Errorf("Update of IPv6 fixed address failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of IPv6 fixed address complete", resourceIpv6FixedAddressIDString(d))
+	return resourceIpv6FixedAddressRead(d, m)
+}
+
+func resourceIpv6FixedAddressDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of IPv6 fixed address", resourceIpv6FixedAddressIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteIpv6FixedAddress(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of IPv6 fixed address failed from network view(%s): %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of IPv6 fixed address complete", resourceIpv6FixedAddressIDString(d))
+	return nil
+}
+
+// resourceIpv6FixedAddressImport accepts either a WAPI reference or
+// <network_view>/<ip_addr> as the import ID.
+func resourceIpv6FixedAddressImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "ipv6fixedaddress") {
+		networkViewName, ipAddr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewIpv6FixedAddress(ibclient.Ipv6FixedAddress{NetviewName: networkViewName, IPv6Address: ipAddr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceIpv6FixedAddressIDStringInterface interface {
+	Id() string
+}
+
+func resourceIpv6FixedAddressIDString(d resourceIpv6FixedAddressIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_ipv6_fixed_address (ID = %s)", id)
+}
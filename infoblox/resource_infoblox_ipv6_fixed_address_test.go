@@ -0,0 +1,118 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceIpv6FixedAddress(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6FixedAddressDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceIpv6FixedAddressCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6FixedAddressExists(t, "infoblox_ipv6_fixed_address.foo"),
+					testAccIpv6FixedAddressExists(t, "infoblox_ipv6_fixed_address.allocated"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.foo", "ip_addr", "2001:db8:30::10"),
+					resource.TestCheckResourceAttrSet("infoblox_ipv6_fixed_address.allocated", "ip_addr"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceIpv6FixedAddressUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6FixedAddressExists(t, "infoblox_ipv6_fixed_address.foo"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.foo", "duid", "00:01:00:01:2a:3b:4c:5e"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_ipv6_fixed_address.foo",
+				ImportState:       true,
+				ImportStateId:     "default/2001:db8:30::10",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6FixedAddressDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_fixed_address" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetIpv6FixedAddressByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("IPv6 fixed address still exists")
+		}
+	}
+	return nil
+}
+
+func testAccIpv6FixedAddressExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetIpv6FixedAddressByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("IPv6 fixed address not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceIpv6FixedAddressCreate = fmt.Sprintf(`
+resource "infoblox_ipv6_network" "net"{
+	cidr="2001:db8:30::/64"
+	tenant_id="foo"
+	}
+resource "infoblox_ipv6_fixed_address" "foo"{
+	ip_addr="2001:db8:30::10"
+	duid="00:01:00:01:2a:3b:4c:5d"
+	tenant_id="foo"
+	depends_on=[infoblox_ipv6_network.net]
+	}
+resource "infoblox_ipv6_fixed_address" "allocated"{
+	cidr=infoblox_ipv6_network.net.cidr
+	duid="00:01:00:01:2a:3b:4c:6d"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceIpv6FixedAddressUpdate = fmt.Sprintf(`
+resource "infoblox_ipv6_network" "net"{
+	cidr="2001:db8:30::/64"
+	tenant_id="foo"
+	}
+resource "infoblox_ipv6_fixed_address" "foo"{
+	ip_addr="2001:db8:30::10"
+	duid="00:01:00:01:2a:3b:4c:5e"
+	comment="updated in place"
+	tenant_id="foo"
+	depends_on=[infoblox_ipv6_network.net]
+	}
+resource "infoblox_ipv6_fixed_address" "allocated"{
+	cidr=infoblox_ipv6_network.net.cidr
+	duid="00:01:00:01:2a:3b:4c:6d"
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,213 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceIpv6Network() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpv6NetworkCreate,
+		Read:   resourceIpv6NetworkRead,
+		Update: resourceIpv6NetworkUpdate,
+		Delete: resourceIpv6NetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpv6NetworkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The IPv6 network block in cidr format.",
+			},
+			"allocate_prefix_len": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+				Description: "Set parameter value>0 to allocate next available IPv6 network with prefix=value from the network container defined by parent_cidr.",
+			},
+			"parent_cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The parent IPv6 network container block in cidr format to allocate from.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the IPv6 network block.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the IPv6 network block.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceIpv6NetworkCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning IPv6 network block Creation", resourceIpv6NetworkIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	parentCidr := d.Get("parent_cidr").(string)
+	prefixLen := d.Get("allocate_prefix_len").(int)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	var network *ibclient.Ipv6Network
+	var err error
+	if cidr == "" && parentCidr != "" && prefixLen > 1 {
+		network, err = objMgr.AllocateIpv6Network(networkViewName, parentCidr, uint(prefixLen), comment, extAttrs)
+		if err != nil {
+			return fmt.Errorf("Allocation of IPv6 network block failed in network view (%s) : %s", networkViewName, err)
+		}
+	} else if cidr != "" {
+		network, err = objMgr.CreateIpv6Network(networkViewName, cidr, comment, extAttrs)
+		if err != nil {
+			return fmt.Errorf("Creation of IPv6 network block failed in network view (%s) : %s", networkViewName, err)
+		}
+	} else {
+		return fmt.Errorf("Creation of IPv6 network block failed: neither cidr nor parent_cidr with allocate_prefix_len was specified.")
+	}
+
+	d.SetId(network.Ref)
+
+	log.Printf("[DEBUG] %s: Creation on IPv6 network block complete", resourceIpv6NetworkIDString(d))
+	return resourceIpv6NetworkRead(d, m)
+}
+
+func resourceIpv6NetworkRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required IPv6 network block", resourceIpv6NetworkIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetIpv6NetworkByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: IPv6 network block not found, removing it from state", resourceIpv6NetworkIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting IPv6 network block from network view (%s) failed : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading IPv6 network block", resourceIpv6NetworkIDString(d))
+	return nil
+}
+
+func resourceIpv6NetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of IPv6 network block", resourceIpv6NetworkIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+
+	_, err := objMgr.UpdateIpv6Network(d.Id(), addEA, removeEA, comment)
+	if err != nil {
+		return fmt.Errorf("Update of IPv6 network block failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of IPv6 network block complete", resourceIpv6NetworkIDString(d))
+	return resourceIpv6NetworkRead(d, m)
+}
+
+func resourceIpv6NetworkDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of IPv6 network block", resourceIpv6NetworkIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteIpv6Network(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of IPv6 network block failed from network view(%s): %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of IPv6 network block complete", resourceIpv6NetworkIDString(d))
+	return nil
+}
+
+// resourceIpv6NetworkImport accepts either a WAPI reference or
+// <network_view>/<cidr> as the import ID.
+func resourceIpv6NetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "ipv6network") {
+		networkViewName, cidr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewIpv6Network(ibclient.Ipv6Network{NetviewName: networkViewName, Cidr: cidr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+	d.Set("allocate_prefix_len", 0)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceIpv6NetworkIDStringInterface interface {
+	Id() string
+}
+
+func resourceIpv6NetworkIDString(d resourceIpv6NetworkIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_ipv6_network (ID = %s)", id)
+}
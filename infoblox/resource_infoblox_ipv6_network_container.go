@@ -0,0 +1,185 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceIpv6NetworkContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpv6NetworkContainerCreate,
+		Read:   resourceIpv6NetworkContainerRead,
+		Update: resourceIpv6NetworkContainerUpdate,
+		Delete: resourceIpv6NetworkContainerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpv6NetworkContainerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv6 network container block in cidr format.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the IPv6 network container.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the IPv6 network container.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceIpv6NetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning IPv6 network container Creation", resourceIpv6NetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	container, err := objMgr.CreateIpv6NetworkContainer(networkViewName, cidr, comment, extAttrs)
+	if err != nil {
+		return fmt.Errorf("Creation of IPv6 network container failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	d.SetId(container.Ref)
+
+	log.Printf("[DEBUG] %s: Creation on IPv6 network container complete", resourceIpv6NetworkContainerIDString(d))
+	return resourceIpv6NetworkContainerRead(d, m)
+}
+
+func resourceIpv6NetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required IPv6 network container", resourceIpv6NetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetIpv6NetworkContainerByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: IPv6 network container not found, removing it from state", resourceIpv6NetworkContainerIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting IPv6 network container from network view (%s) failed : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading IPv6 network container", resourceIpv6NetworkContainerIDString(d))
+	return nil
+}
+
+func resourceIpv6NetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of IPv6 network container", resourceIpv6NetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+
+	_, err := objMgr.UpdateIpv6NetworkContainer(d.Id(), addEA, removeEA, comment)
+	if err != nil {
+		return fmt.Errorf("Update of IPv6 network container failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of IPv6 network container complete", resourceIpv6NetworkContainerIDString(d))
+	return resourceIpv6NetworkContainerRead(d, m)
+}
+
+func resourceIpv6NetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of IPv6 network container", resourceIpv6NetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteIpv6NetworkContainer(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of IPv6 network container failed from network view(%s): %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of IPv6 network container complete", resourceIpv6NetworkContainerIDString(d))
+	return nil
+}
+
+// resourceIpv6NetworkContainerImport accepts either a WAPI reference or
+// <network_view>/<cidr> as the import ID.
+func resourceIpv6NetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "ipv6networkcontainer") {
+		networkViewName, cidr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewIpv6NetworkContainer(ibclient.Ipv6NetworkContainer{NetviewName: networkViewName, Cidr: cidr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceIpv6NetworkContainerIDStringInterface interface {
+	Id() string
+}
+
+func resourceIpv6NetworkContainerIDString(d resourceIpv6NetworkContainerIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_ipv6_network_container (ID = %s)", id)
+}
@@ -0,0 +1,94 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceIpv6NetworkContainer(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6NetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceIpv6NetworkContainerCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6NetworkContainerExists(t, "infoblox_ipv6_network_container.foo"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network_container.foo", "cidr", "2001:db8:10::/48"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network_container.foo", "network_view_name", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceIpv6NetworkContainerUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6NetworkContainerExists(t, "infoblox_ipv6_network_container.foo"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network_container.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_ipv6_network_container.foo",
+				ImportState:       true,
+				ImportStateId:     "default/2001:db8:10::/48",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6NetworkContainerDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_network_container" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetIpv6NetworkContainerByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("IPv6 network container still exists")
+		}
+	}
+	return nil
+}
+
+func testAccIpv6NetworkContainerExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetIpv6NetworkContainerByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("IPv6 network container not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceIpv6NetworkContainerCreate = fmt.Sprintf(`
+resource "infoblox_ipv6_network_container" "foo"{
+	cidr="2001:db8:10::/48"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceIpv6NetworkContainerUpdate = fmt.Sprintf(`
+resource "infoblox_ipv6_network_container" "foo"{
+	cidr="2001:db8:10::/48"
+	comment="updated in place"
+	tenant_id="foo"
+	}`)
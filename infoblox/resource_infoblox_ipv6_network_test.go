@@ -0,0 +1,115 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceIpv6Network(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceIpv6NetworkCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6NetworkExists(t, "infoblox_ipv6_network.foo"),
+					testAccIpv6NetworkExists(t, "infoblox_ipv6_network.allocated"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.foo", "cidr", "2001:db8:20::/64"),
+					resource.TestCheckResourceAttrSet("infoblox_ipv6_network.allocated", "cidr"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceIpv6NetworkUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIpv6NetworkExists(t, "infoblox_ipv6_network.foo"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_ipv6_network.foo",
+				ImportState:       true,
+				ImportStateId:     "default/2001:db8:20::/64",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpv6NetworkDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_network" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetIpv6NetworkByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("IPv6 network still exists")
+		}
+	}
+	return nil
+}
+
+func testAccIpv6NetworkExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetIpv6NetworkByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("IPv6 network not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceIpv6NetworkCreate = fmt.Sprintf(`
+resource "infoblox_ipv6_network_container" "parent"{
+	cidr="2001:db8:20::/48"
+	tenant_id="foo"
+	}
+resource "infoblox_ipv6_network" "foo"{
+	cidr="2001:db8:20::/64"
+	tenant_id="foo"
+	depends_on=[infoblox_ipv6_network_container.parent]
+	}
+resource "infoblox_ipv6_network" "allocated"{
+	parent_cidr=infoblox_ipv6_network_container.parent.cidr
+	allocate_prefix_len=64
+	tenant_id="foo"
+	}`)
+
+var testAccresourceIpv6NetworkUpdate = fmt.Sprintf(`
+resource "infoblox_ipv6_network_container" "parent"{
+	cidr="2001:db8:20::/48"
+	tenant_id="foo"
+	}
+resource "infoblox_ipv6_network" "foo"{
+	cidr="2001:db8:20::/64"
+	comment="updated in place"
+	tenant_id="foo"
+	depends_on=[infoblox_ipv6_network_container.parent]
+	}
+resource "infoblox_ipv6_network" "allocated"{
+	parent_cidr=infoblox_ipv6_network_container.parent.cidr
+	allocate_prefix_len=64
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,227 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMXRecordCreate,
+		Read:   resourceMXRecordGet,
+		Update: resourceMXRecordUpdate,
+		Delete: resourceMXRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMXRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Fully qualified domain name the mail exchanger serves.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"mail_exchanger": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name of the mail exchanger.",
+			},
+			"preference": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+				Description:  "Preference of the mail exchanger, lower values are preferred.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the MX record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the MX record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the MX record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the MX record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+// buildMXRecord returns the MX record described by the arguments in d,
+// without the DNS view which cannot be updated.
+func buildMXRecord(d *schema.ResourceData) ibclient.RecordMX {
+	preference := uint(d.Get("preference").(int))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.RecordMX{
+		Name:          d.Get("fqdn").(string),
+		MailExchanger: d.Get("mail_exchanger").(string),
+		Preference:    &preference,
+		Ttl:           ttl,
+		UseTtl:        useTTL,
+		Comment:       &comment,
+		Disable:       &disable,
+		Ea:            eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create MX record", resourceMXRecordIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordMX := buildMXRecord(d)
+	recordMX.View = dnsView
+	obj, err := objMgr.CreateMXRecord(recordMX)
+	if err != nil {
+		return fmt.Errorf("Error creating MX Record (%s) in dns view (%s): %s", fqdn, dnsView, err)
+	}
+	d.SetId(obj.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of MX Record complete", resourceMXRecordIDString(d))
+	return resourceMXRecordGet(d, m)
+}
+
+func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get MX Record", resourceMXRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetMXRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: MX Record not found, removing it from state", resourceMXRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting MX record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Name)
+	d.Set("dns_view", obj.View)
+	d.Set("mail_exchanger", obj.MailExchanger)
+	if obj.Preference != nil {
+		d.Set("preference", int(*obj.Preference))
+	}
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required MX Record ", resourceMXRecordIDString(d))
+	return nil
+}
+
+func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of MX Record", resourceMXRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateMXRecord(d.Id(), buildMXRecord(d))
+	if err != nil {
+		return fmt.Errorf("Updating MX Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of MX Record complete", resourceMXRecordIDString(d))
+	return resourceMXRecordGet(d, m)
+}
+
+func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of MX Record", resourceMXRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteMXRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of MX Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of MX Record complete", resourceMXRecordIDString(d))
+	return nil
+}
+
+// resourceMXRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn>/<mail_exchanger> as the import ID, as a name usually
+// has several MX records.
+func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:mx") {
+		dnsView, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		fqdn, mailExchanger, err := splitImportID(key)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid import ID (%s): expected a WAPI reference or <dns_view>/<fqdn>/<mail_exchanger>", d.Id())
+		}
+		search := ibclient.NewRecordMX(ibclient.RecordMX{View: dnsView, Name: fqdn, MailExchanger: mailExchanger})
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceMXRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceMXRecordIDString(d resourceMXRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_mx_record (ID = %s)", id)
+}
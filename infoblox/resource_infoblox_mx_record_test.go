@@ -0,0 +1,171 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceMXRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMXRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceMXRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccMXRecordExists(t, "infoblox_mx_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "fqdn", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "mail_exchanger", "mx1.a.com"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "preference", "10"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceMXRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccMXRecordExists(t, "infoblox_mx_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "mail_exchanger", "mx2.a.com"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "preference", "20"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "ttl", "3600"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "comment", "backup mail exchanger"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "disable", "true"),
+					resource.TestCheckResourceAttr("infoblox_mx_record.foo", "ext_attrs.Site", "HQ"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_mx_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/a.com/mx2.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMXRecordMapping(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceMXRecord().Schema, map[string]interface{}{
+		"fqdn":           "a.com",
+		"mail_exchanger": "mx1.a.com",
+		"preference":     10,
+		"ttl":            3600,
+		"ext_attrs":      map[string]interface{}{"Site": "HQ"},
+		"tenant_id":      "foo",
+	})
+	connector, requestor := testConnector(
+		`"record:mx/ZG5z:a.com/default"`,
+		`{"_ref": "record:mx/ZG5z:a.com/default", "name": "a.com", "view": "default",
+		  "mail_exchanger": "mx2.a.com", "preference": 20, "ttl": 600, "use_ttl": true,
+		  "comment": "backup", "disable": true,
+		  "extattrs": {"Site": {"value": "DC"}, "Tenant ID": {"value": "bar"}, "CMP Type": {"value": "Terraform"}}}`,
+	)
+
+	if err := resourceMXRecordCreate(d, connector); err != nil {
+		t.Fatalf("resourceMXRecordCreate returned error %v", err)
+	}
+
+	obj := requestor.requests[0].object(t)
+	for key, value := range map[string]interface{}{
+		"name":           "a.com",
+		"view":           "default",
+		"mail_exchanger": "mx1.a.com",
+		"preference":     10.0,
+		"ttl":            3600.0,
+		"use_ttl":        true,
+		"disable":        false,
+	} {
+		if obj[key] != value {
+			t.Fatalf("create request sent %s %v, expected %v", key, obj[key], value)
+		}
+	}
+	ea := obj["extattrs"].(map[string]interface{})
+	if ea["Site"].(map[string]interface{})["value"] != "HQ" || ea["Tenant ID"].(map[string]interface{})["value"] != "foo" {
+		t.Fatalf("create request sent extattrs %v", ea)
+	}
+
+	if d.Id() != "record:mx/ZG5z:a.com/default" {
+		t.Fatalf("resourceMXRecordCreate set ID %s", d.Id())
+	}
+	for key, value := range map[string]interface{}{
+		"mail_exchanger": "mx2.a.com",
+		"preference":     20,
+		"ttl":            600,
+		"comment":        "backup",
+		"disable":        true,
+		"tenant_id":      "bar",
+	} {
+		if d.Get(key) != value {
+			t.Fatalf("state has %s %v, expected %v", key, d.Get(key), value)
+		}
+	}
+	if extAttrs := d.Get("ext_attrs").(map[string]interface{}); len(extAttrs) != 1 || extAttrs["Site"] != "DC" {
+		t.Fatalf("state has ext_attrs %v, expected Site only", extAttrs)
+	}
+}
+
+func testAccCheckMXRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_mx_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetMXRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("MX record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccMXRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetMXRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("MX record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceMXRecordCreate = fmt.Sprintf(`
+resource "infoblox_mx_record" "foo"{
+	fqdn="a.com"
+	mail_exchanger="mx1.a.com"
+	preference=10
+	tenant_id="foo"
+	}`)
+
+var testAccresourceMXRecordUpdate = fmt.Sprintf(`
+resource "infoblox_mx_record" "foo"{
+	fqdn="a.com"
+	mail_exchanger="mx2.a.com"
+	preference=20
+	ttl=3600
+	comment="backup mail exchanger"
+	disable=true
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
@@ -14,12 +14,16 @@ func resourceNetwork() *schema.Resource {
 		Read:   resourceNetworkRead,
 		Update: resourceNetworkUpdate,
 		Delete: resourceNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_view_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "default",
+				ForceNew:    true,
 				Description: "Network view name available in NIOS Server.",
 			},
 			"network_name": &schema.Schema{
@@ -31,8 +35,27 @@ func resourceNetwork() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				ForceNew:    true,
 				Description: "The network block in cidr format.",
 			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the network block.",
+			},
+			"dhcp_option": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionResource(),
+				Description: "DHCP options sent to the clients of the network block.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the network block.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -44,21 +67,34 @@ func resourceNetwork() *schema.Resource {
 				Default:     0,
 				Description: "The no of IP's you want to reserve.",
 			},
+			"reserved_ips": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses reserved for reserve_ip.",
+			},
 			"gateway": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "gateway ip address of your network block.By default first IPv4 address is set as gateway address.",
 				Computed:    true,
 			},
+			"gateway_allocated": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the gateway address was allocated by the provider, which then releases it when the gateway changes.",
+			},
 			"allocate_prefix_len": &schema.Schema{
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     0,
+				ForceNew:    true,
 				Description: "Set parameter value>0 to allocate next available network with prefix=value from network container defined by parent_cidr.",
 			},
 			"parent_cidr": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "The parent network container block in cidr format to allocate from.",
 			},
 		},
@@ -72,59 +108,124 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}) error {
 	cidr := d.Get("cidr").(string)
 	parent_cidr := d.Get("parent_cidr").(string)
 	networkName := d.Get("network_name").(string)
+	comment := d.Get("comment").(string)
 	reserveIP := d.Get("reserve_ip").(int)
 	gateway := d.Get("gateway").(string)
 	tenantID := d.Get("tenant_id").(string)
 	connector := m.(*ibclient.Connector)
 	prefixLen := d.Get("allocate_prefix_len").(int)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+	options := dhcpOptions(d.Get("dhcp_option").([]interface{}))
 
-	ZeroMacAddr := "00:00:00:00:00:00"
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-	ea := make(ibclient.EA)
 
 	var network *ibclient.Network
 	var err error
 	if cidr == "" && parent_cidr != "" && prefixLen > 1 {
-		network, err = objMgr.AllocateNetwork(networkViewName, parent_cidr, uint(prefixLen), networkName)
+		network, err = objMgr.AllocateNetwork(networkViewName, parent_cidr, uint(prefixLen), networkName, comment, options, extAttrs)
 		if err != nil {
 			return fmt.Errorf("Allocation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
 		d.Set("cidr", network.Cidr)
 	} else if cidr != "" {
-		network, err = objMgr.CreateNetwork(networkViewName, cidr, networkName)
+		network, err = objMgr.CreateNetwork(networkViewName, cidr, networkName, comment, options, extAttrs)
 		if err != nil {
 			return fmt.Errorf("Creation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
 	} else {
 		return fmt.Errorf("Creation of network block failed: neither cidr nor parent_cidr with allocate_prefix_len was specified.")
 	}
+	d.SetId(network.Ref)
 
-	// Check whether gateway or ip address already allocated
 	if gateway != "none" {
-		gatewayIP, err := objMgr.GetFixedAddress(networkViewName, network.Cidr, gateway, "")
+		gatewayIP, allocated, err := allocateGateway(objMgr, networkViewName, network.Cidr, gateway)
+		if err != nil {
+			d.Set("gateway", "none")
+			return fmt.Errorf("Gateway Creation failed in network block(%s) error: %s", network.Cidr, err)
+		}
+		d.Set("gateway", gatewayIP)
+		d.Set("gateway_allocated", allocated)
+	}
+
+	// The reservations are children of the network and are deleted together
+	// with it.
+	reservedIPs, err := reserveIPs(objMgr, networkViewName, network.Cidr, reserveIP, make([]interface{}, 0, reserveIP))
+	d.Set("reserved_ips", reservedIPs)
+	if err != nil {
+		d.Set("reserve_ip", len(reservedIPs))
+		return fmt.Errorf("Reservation in network block failed in network view(%s):%s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Creation on network block complete", resourceNetworkIDString(d))
+	return resourceNetworkRead(d, m)
+}
+
+// allocateGateway allocates the gateway address of the network cidr as a
+// fixed address with the zero MAC address and returns it. An existing fixed
+// address is used as is, which is reported by returning false. The next
+// available IP is allocated when gateway is empty.
+func allocateGateway(objMgr *ibclient.ObjectManager, networkViewName string, cidr string, gateway string) (string, bool, error) {
+	if gateway != "" {
+		gatewayIP, err := objMgr.GetFixedAddress(networkViewName, cidr, gateway, "")
 		if err == nil && gatewayIP != nil {
-			fmt.Printf("Gateway already created")
-		} else if gatewayIP == nil {
-			gatewayIP, err = objMgr.AllocateIP(networkViewName, network.Cidr, gateway, ZeroMacAddr, "", ea)
-			if err != nil {
-				return fmt.Errorf("Gateway Creation failed in network block(%s) error: %s", network.Cidr, err)
-			}
+			log.Printf("[DEBUG] Gateway %s already allocated in network block (%s)", gateway, cidr)
+			return gatewayIP.IPAddress, false, nil
 		}
-		d.Set("gateway", gatewayIP.IPAddress)
 	}
+	gatewayIP, err := objMgr.AllocateIP(networkViewName, cidr, gateway, ibclient.MACADDR_ZERO, "", nil)
+	if err != nil {
+		return "", false, err
+	}
+	return gatewayIP.IPAddress, true, nil
+}
 
-	for i := 1; i <= reserveIP; i++ {
-		_, err = objMgr.AllocateIP(networkViewName, network.Cidr, gateway, ZeroMacAddr, "", ea)
+// reserveIPs reserves the next count available IPs of the network cidr and
+// returns reservedIPs with their addresses appended. On error the addresses
+// reserved so far are returned.
+func reserveIPs(objMgr *ibclient.ObjectManager, networkViewName string, cidr string, count int, reservedIPs []interface{}) ([]interface{}, error) {
+	for i := 1; i <= count; i++ {
+		reservation, err := objMgr.ReserveIP(networkViewName, cidr, "", "", "", nil)
 		if err != nil {
-			return fmt.Errorf("Reservation in network block failed in network view(%s):%s", networkViewName, err)
+			return reservedIPs, err
 		}
+		reservedIPs = append(reservedIPs, reservation.IPAddress)
 	}
+	return reservedIPs, nil
+}
 
-	d.SetId(network.Ref)
+// releaseReservedIPs deletes the reservations of the addresses in ips.
+func releaseReservedIPs(objMgr *ibclient.ObjectManager, networkViewName string, cidr string, ips []interface{}) error {
+	for _, ip := range ips {
+		reservation, err := objMgr.GetFixedAddress(networkViewName, cidr, ip.(string), "")
+		if err != nil {
+			return err
+		}
+		if reservation == nil || reservation.MatchClient != "RESERVED" {
+			continue
+		}
+		if _, err := objMgr.DeleteFixedAddress(reservation.Ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	log.Printf("[DEBUG] %s: Creation on network block complete", resourceNetworkIDString(d))
-	return resourceNetworkRead(d, m)
+// reservedIPsForState returns the addresses of reservedIPs which are still
+// reserved in NIOS.
+func reservedIPsForState(reservedIPs []interface{}, reservations []ibclient.FixedAddress) []interface{} {
+	reserved := make(map[string]bool)
+	for _, reservation := range reservations {
+		reserved[reservation.IPAddress] = true
+	}
+	res := make([]interface{}, 0)
+	for _, v := range reservedIPs {
+		if reserved[v.(string)] {
+			res = append(res, v)
+		}
+	}
+	return res
 }
+
 func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Reading the required network block", resourceNetworkIDString(d))
 
@@ -136,15 +237,126 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 
 	obj, err := objMgr.GetNetworkwithref(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: network block not found, removing it from state", resourceNetworkIDString(d))
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Getting Network block from network view (%s) failed : %s", networkViewName, err)
 	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	d.Set("network_name", getEAValue(obj.Ea, "Network Name"))
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	if err := d.Set("dhcp_option", dhcpOptionsForState(d.Get("dhcp_option").([]interface{}), obj.Options)); err != nil {
+		return err
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+	if gateway := d.Get("gateway").(string); gateway != "" && gateway != "none" {
+		gatewayIP, err := objMgr.GetFixedAddress(obj.NetviewName, obj.Cidr, gateway, "")
+		if err != nil {
+			return fmt.Errorf("Getting gateway of network block (%s) failed : %s", obj.Cidr, err)
+		}
+		if gatewayIP == nil {
+			log.Printf("[WARN] %s: gateway %s not found, removing it from state", resourceNetworkIDString(d), gateway)
+			d.Set("gateway", "")
+			d.Set("gateway_allocated", false)
+		}
+	}
+	if reservedIPs := d.Get("reserved_ips").([]interface{}); len(reservedIPs) > 0 {
+		reservations, err := objMgr.GetReservations(obj.NetviewName, obj.Cidr)
+		if err != nil {
+			return fmt.Errorf("Getting reservations of network block (%s) failed : %s", obj.Cidr, err)
+		}
+		reservedIPs = reservedIPsForState(reservedIPs, reservations)
+		d.Set("reserved_ips", reservedIPs)
+		d.Set("reserve_ip", len(reservedIPs))
+	}
+
 	d.SetId(obj.Ref)
 	log.Printf("[DEBUG] %s: Completed reading network block", resourceNetworkIDString(d))
 	return nil
 }
 func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of network block", resourceNetworkIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	networkName := d.Get("network_name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("network_name") {
+		if networkName != "" {
+			addEA["Network Name"] = networkName
+		} else {
+			removeEA["Network Name"] = ""
+		}
+	}
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+
+	_, err := objMgr.UpdateNetwork(d.Id(), addEA, removeEA, comment)
+	if err != nil {
+		return fmt.Errorf("Update of network block failed in network view (%s) : %s", networkViewName, err)
+	}
+	if d.HasChange("dhcp_option") {
+		_, err = objMgr.UpdateNetworkOptions(d.Id(), dhcpOptions(d.Get("dhcp_option").([]interface{})))
+		if err != nil {
+			return fmt.Errorf("Update of DHCP options of network block failed in network view (%s) : %s", networkViewName, err)
+		}
+	}
+	if d.HasChange("gateway") {
+		oldGateway, newGateway := d.GetChange("gateway")
+		// Fixed addresses which existed before are left to their owner.
+		if d.Get("gateway_allocated").(bool) && oldGateway.(string) != "" && oldGateway.(string) != "none" {
+			_, err = objMgr.ReleaseIP(networkViewName, cidr, oldGateway.(string), ibclient.MACADDR_ZERO)
+			if err != nil {
+				d.Set("gateway", oldGateway)
+				return fmt.Errorf("Release of gateway (%s) failed in network block (%s) : %s", oldGateway, cidr, err)
+			}
+		}
+		d.Set("gateway_allocated", false)
+		if newGateway.(string) != "none" {
+			gateway, allocated, err := allocateGateway(objMgr, networkViewName, cidr, newGateway.(string))
+			if err != nil {
+				d.Set("gateway", "none")
+				return fmt.Errorf("Gateway Creation failed in network block(%s) error: %s", cidr, err)
+			}
+			d.Set("gateway", gateway)
+			d.Set("gateway_allocated", allocated)
+		}
+	}
+	if d.HasChange("reserve_ip") {
+		reserveIP := d.Get("reserve_ip").(int)
+		reservedIPs := d.Get("reserved_ips").([]interface{})
+		if reserveIP > len(reservedIPs) {
+			reservedIPs, err = reserveIPs(objMgr, networkViewName, cidr, reserveIP-len(reservedIPs), reservedIPs)
+		} else if reserveIP < len(reservedIPs) {
+			err = releaseReservedIPs(objMgr, networkViewName, cidr, reservedIPs[reserveIP:])
+			if err == nil {
+				reservedIPs = reservedIPs[:reserveIP]
+			}
+		}
+		d.Set("reserved_ips", reservedIPs)
+		if err != nil {
+			d.Set("reserve_ip", len(reservedIPs))
+			return fmt.Errorf("Update of reservations in network block (%s) failed : %s", cidr, err)
+		}
+	}
 
-	return fmt.Errorf("network updation is not supported")
+	log.Printf("[DEBUG] %s: Update of network block complete", resourceNetworkIDString(d))
+	return resourceNetworkRead(d, m)
 }
 
 func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
@@ -166,6 +378,28 @@ func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceNetworkImport accepts either a WAPI reference or
+// <network_view>/<cidr> as the import ID.
+func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "network") {
+		networkViewName, cidr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewNetwork(ibclient.Network{NetviewName: networkViewName, Cidr: cidr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+	d.Set("reserve_ip", 0)
+	d.Set("allocate_prefix_len", 0)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceNetworkIDStringInterface interface {
 	Id() string
 }
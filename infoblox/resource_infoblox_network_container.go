@@ -0,0 +1,213 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceNetworkContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkContainerCreate,
+		Read:   resourceNetworkContainerRead,
+		Update: resourceNetworkContainerUpdate,
+		Delete: resourceNetworkContainerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkContainerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Network view name available in NIOS Server.",
+			},
+			"cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The network container in cidr format.",
+			},
+			"allocate_prefix_len": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+				Description: "Set parameter value>0 to allocate next available network container with prefix=value from the network container defined by parent_cidr.",
+			},
+			"parent_cidr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The parent network container block in cidr format to allocate from.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the network container.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the network container.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning network container Creation", resourceNetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	cidr := d.Get("cidr").(string)
+	parentCidr := d.Get("parent_cidr").(string)
+	prefixLen := d.Get("allocate_prefix_len").(int)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+	extAttrs := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	var container *ibclient.NetworkContainer
+	var err error
+	if cidr == "" && parentCidr != "" && prefixLen > 1 {
+		container, err = objMgr.AllocateNetworkContainer(networkViewName, parentCidr, uint(prefixLen), comment, extAttrs)
+		if err != nil {
+			return fmt.Errorf("Allocation of network container failed in network view (%s) : %s", networkViewName, err)
+		}
+	} else if cidr != "" {
+		container, err = objMgr.CreateNetworkContainer(networkViewName, cidr, comment, extAttrs)
+		if err != nil {
+			return fmt.Errorf("Creation of network container failed in network view (%s) : %s", networkViewName, err)
+		}
+	} else {
+		return fmt.Errorf("Creation of network container failed: neither cidr nor parent_cidr with allocate_prefix_len was specified.")
+	}
+
+	d.SetId(container.Ref)
+
+	log.Printf("[DEBUG] %s: Creation on network container complete", resourceNetworkContainerIDString(d))
+	return resourceNetworkContainerRead(d, m)
+}
+
+func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required network container", resourceNetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetNetworkContainerByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: network container not found, removing it from state", resourceNetworkContainerIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting network container from network view (%s) failed : %s", networkViewName, err)
+	}
+	d.Set("network_view_name", obj.NetviewName)
+	d.Set("cidr", obj.Cidr)
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading network container", resourceNetworkContainerIDString(d))
+	return nil
+}
+
+func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of network container", resourceNetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	comment := d.Get("comment").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+
+	_, err := objMgr.UpdateNetworkContainer(d.Id(), addEA, removeEA, comment)
+	if err != nil {
+		return fmt.Errorf("Update of network container failed in network view (%s) : %s", networkViewName, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of network container complete", resourceNetworkContainerIDString(d))
+	return resourceNetworkContainerRead(d, m)
+}
+
+func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of network container", resourceNetworkContainerIDString(d))
+
+	networkViewName := d.Get("network_view_name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteNetworkContainer(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of network container failed from network view(%s): %s", networkViewName, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of network container complete", resourceNetworkContainerIDString(d))
+	return nil
+}
+
+// resourceNetworkContainerImport accepts either a WAPI reference or
+// <network_view>/<cidr> as the import ID.
+func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "networkcontainer") {
+		networkViewName, cidr, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewNetworkContainer(ibclient.NetworkContainer{NetviewName: networkViewName, Cidr: cidr}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+	d.Set("allocate_prefix_len", 0)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceNetworkContainerIDStringInterface interface {
+	Id() string
+}
+
+func resourceNetworkContainerIDString(d resourceNetworkContainerIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_network_container (ID = %s)", id)
+}
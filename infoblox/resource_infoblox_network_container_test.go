@@ -0,0 +1,130 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceNetworkContainer(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceNetworkContainerCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNetworkContainerExists(t, "infoblox_network_container.foo"),
+					resource.TestCheckResourceAttr("infoblox_network_container.foo", "cidr", "10.110.0.0/16"),
+					resource.TestCheckResourceAttr("infoblox_network_container.foo", "network_view_name", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceNetworkContainerUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNetworkContainerExists(t, "infoblox_network_container.foo"),
+					resource.TestCheckResourceAttr("infoblox_network_container.foo", "comment", "updated in place"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_network_container.foo",
+				ImportState:       true,
+				ImportStateId:     "default/10.110.0.0/16",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNetworkContainer_Allocate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceNetworkContainerAllocate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNetworkContainerExists(t, "infoblox_network_container.parent"),
+					testAccNetworkContainerExists(t, "infoblox_network_container.child"),
+					resource.TestCheckResourceAttr("infoblox_network_container.child", "cidr", "10.120.0.0/20"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "cidr", "10.120.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkContainerDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_network_container" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetNetworkContainerByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("network container still exists")
+		}
+	}
+	return nil
+}
+
+func testAccNetworkContainerExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetNetworkContainerByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("network container not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceNetworkContainerCreate = fmt.Sprintf(`
+resource "infoblox_network_container" "foo"{
+	cidr="10.110.0.0/16"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceNetworkContainerUpdate = fmt.Sprintf(`
+resource "infoblox_network_container" "foo"{
+	cidr="10.110.0.0/16"
+	comment="updated in place"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceNetworkContainerAllocate = fmt.Sprintf(`
+resource "infoblox_network_container" "parent"{
+	cidr="10.120.0.0/16"
+	tenant_id="foo"
+	}
+resource "infoblox_network_container" "child"{
+	parent_cidr=infoblox_network_container.parent.cidr
+	allocate_prefix_len=20
+	tenant_id="foo"
+	}
+resource "infoblox_network" "foo"{
+	parent_cidr=infoblox_network_container.child.cidr
+	allocate_prefix_len=24
+	tenant_id="foo"
+	}`)
@@ -2,9 +2,11 @@ package infoblox
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	ibclient "github.com/infobloxopen/infoblox-go-client"
 )
@@ -20,14 +22,31 @@ func TestAccresourceNetwork(t *testing.T) {
 				Config: testAccresourceNetworkCreate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCreateNetworkExists(t, "infoblox_network.foo", "10.10.0.0/24", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "cidr", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "network_name", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "gateway_allocated", "true"),
 				),
 			},
 			resource.TestStep{
 				Config: testAccresourceNetworkUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCreateNetworkExists(t, "infoblox_network.foo", "10.10.0.0/24", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "network_name", "demo-network-updated"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "comment", "updated in place"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "ext_attrs.Site", "HQ"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "dhcp_option.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "dhcp_option.0.name", "routers"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "dhcp_option.0.num", "3"),
+					resource.TestCheckResourceAttr("infoblox_network.foo", "dhcp_option.1.use_option", "false"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "infoblox_network.foo",
+				ImportState:             true,
+				ImportStateId:           "default/10.10.0.0/24",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"gateway", "gateway_allocated"},
+			},
 		},
 	})
 }
@@ -44,12 +63,113 @@ func TestAccresourceNetwork_Allocate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCreateNetworkExists(t, "infoblox_network.foo0", "10.0.0.0/24", "default", "demo-network"),
 					testAccCreateNetworkExists(t, "infoblox_network.foo1", "10.0.1.0/24", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_network.foo1", "reserved_ips.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceNetworkAllocateUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCreateNetworkExists(t, "infoblox_network.foo1", "10.0.1.0/24", "default", "demo-network"),
+					resource.TestCheckResourceAttr("infoblox_network.foo1", "reserved_ips.#", "1"),
 				),
 			},
 		},
 	})
 }
 
+func TestReservedIPsForState(t *testing.T) {
+	reservations := []ibclient.FixedAddress{
+		{IPAddress: "10.0.0.2"},
+		{IPAddress: "10.0.0.4"},
+		{IPAddress: "10.0.0.9"},
+	}
+
+	res := reservedIPsForState([]interface{}{"10.0.0.4", "10.0.0.3", "10.0.0.2"}, reservations)
+	expected := []interface{}{"10.0.0.4", "10.0.0.2"}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("reservedIPsForState returned %v, expected %v", res, expected)
+	}
+}
+
+func TestNetworkReadReservations(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetwork().Schema, map[string]interface{}{
+		"cidr":         "10.0.0.0/24",
+		"gateway":      "none",
+		"reserve_ip":   3,
+		"reserved_ips": []interface{}{"10.0.0.2", "10.0.0.3", "10.0.0.4"},
+		"tenant_id":    "foo",
+	})
+	d.SetId("network/ZG5z:10.0.0.0/24/default")
+	connector, _ := testConnector(
+		`{"_ref": "network/ZG5z:10.0.0.0/24/default", "network": "10.0.0.0/24", "network_view": "default",
+		  "extattrs": {"Tenant ID": {"value": "foo"}}}`,
+		// 10.0.0.3 was released outside of Terraform.
+		`[{"_ref": "fixedaddress/ZG5z:10.0.0.2/default", "ipv4addr": "10.0.0.2", "match_client": "RESERVED"},
+		  {"_ref": "fixedaddress/ZG5z:10.0.0.4/default", "ipv4addr": "10.0.0.4", "match_client": "RESERVED"}]`,
+	)
+
+	if err := resourceNetworkRead(d, connector); err != nil {
+		t.Fatalf("resourceNetworkRead returned error %v", err)
+	}
+	expected := []interface{}{"10.0.0.2", "10.0.0.4"}
+	if !reflect.DeepEqual(d.Get("reserved_ips"), expected) || d.Get("reserve_ip") != 2 {
+		t.Fatalf("state has reserved_ips %v and reserve_ip %v, expected %v and 2", d.Get("reserved_ips"), d.Get("reserve_ip"), expected)
+	}
+}
+
+func TestNetworkCreateMapping(t *testing.T) {
+	network := `{"_ref": "network/ZG5z:10.0.0.0/24/default", "network": "10.0.0.0/24", "network_view": "default",
+	  "options": [{"name": "domain-name", "num": 15, "value": "a.com", "vendor_class": "DHCP"}],
+	  "extattrs": {"Network Name": {"value": "web"}, "Tenant ID": {"value": "foo"}}}`
+
+	cases := []struct {
+		raw     map[string]interface{}
+		network string
+	}{
+		{map[string]interface{}{"cidr": "10.0.0.0/24"}, "10.0.0.0/24"},
+		{map[string]interface{}{"parent_cidr": "10.0.0.0/16", "allocate_prefix_len": 24},
+			"func:nextavailablenetwork:10.0.0.0/16,default,24"},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"network_name": "web",
+			"gateway":      "none",
+			"tenant_id":    "foo",
+			"dhcp_option": []interface{}{
+				map[string]interface{}{"name": "domain-name", "value": "a.com"},
+			},
+		}
+		for k, v := range tc.raw {
+			raw[k] = v
+		}
+		d := schema.TestResourceDataRaw(t, resourceNetwork().Schema, raw)
+		connector, requestor := testConnector(`"network/ZG5z:10.0.0.0/24/default"`, network)
+
+		if err := resourceNetworkCreate(d, connector); err != nil {
+			t.Fatalf("resourceNetworkCreate returned error %v", err)
+		}
+		if len(requestor.requests) != 2 {
+			t.Fatalf("create sent %d requests, expected the DHCP options to be set by the create request", len(requestor.requests))
+		}
+		obj := requestor.requests[0].object(t)
+		if requestor.requests[0].method != "POST" || obj["network"] != tc.network {
+			t.Fatalf("create request sent %v", obj)
+		}
+		options := obj["options"].([]interface{})
+		if len(options) != 1 {
+			t.Fatalf("create request sent options %v", options)
+		}
+		option := options[0].(map[string]interface{})
+		if option["name"] != "domain-name" || option["value"] != "a.com" || option["vendor_class"] != "DHCP" {
+			t.Fatalf("create request sent option %v", option)
+		}
+		if d.Get("cidr") != "10.0.0.0/24" || d.Get("dhcp_option.0.value") != "a.com" || d.Get("dhcp_option.0.num") != 15 {
+			t.Fatalf("state has cidr %v and dhcp_option %v", d.Get("cidr"), d.Get("dhcp_option"))
+		}
+	}
+}
+
 func testAccCheckNetworkDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 	for _, rs := range s.RootModule().Resources {
@@ -115,12 +235,47 @@ resource "infoblox_network" "foo1"{
 	tenant_id="foo"
 	allocate_prefix_len=24
 	parent_cidr="10.0.0.0/16"
+	reserve_ip=2
 	}`)
 
+var testAccresourceNetworkAllocateUpdate = fmt.Sprintf(`
+resource "infoblox_network" "foo0"{
+	network_view_name="default"
+	network_name="demo-network"
+	tenant_id="foo"
+	allocate_prefix_len=24
+	parent_cidr="10.0.0.0/16"
+	}
+resource "infoblox_network" "foo1"{
+	network_view_name="default"
+	network_name="demo-network"
+	tenant_id="foo"
+	allocate_prefix_len=24
+	parent_cidr="10.0.0.0/16"
+	reserve_ip=1
+	}`)
+
+/*
+The "Site" extensible attribute definition must exist in NIOS
+before running acceptance test TestAccresourceNetwork
+*/
 var testAccresourceNetworkUpdate = fmt.Sprintf(`
 resource "infoblox_network" "foo"{
 	network_view_name="default"
-	network_name="demo-network"
+	network_name="demo-network-updated"
+	comment="updated in place"
+	ext_attrs={
+		"Site"="HQ"
+	}
+	dhcp_option {
+		name="routers"
+		value="10.10.0.1"
+	}
+	dhcp_option {
+		name="dhcp-lease-time"
+		value="3600"
+		use_option=false
+	}
 	cidr="10.10.0.0/24"
 	tenant_id="foo"
 	}`)
@@ -13,13 +13,24 @@ func resourceNetworkView() *schema.Resource {
 		Read:   resourceNetworkViewRead,
 		Update: resourceNetworkViewUpdate,
 		Delete: resourceNetworkViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkViewImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_view_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Desired name of the view shown in NIOS appliance.",
 			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the network view.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -36,7 +47,7 @@ func resourceNetworkViewCreate(d *schema.ResourceData, m interface{}) error {
 	Connector := m.(*ibclient.Connector)
 	objMgr := ibclient.NewObjectManager(Connector, "Terraform", tenantID)
 
-	networkViewName, err := objMgr.CreateNetworkView(d.Get("network_view_name").(string))
+	networkViewName, err := objMgr.CreateNetworkView(d.Get("network_view_name").(string), eaFromExtAttrs(d.Get("ext_attrs")))
 	if err != nil {
 		return fmt.Errorf("Failed to create Network View : %s", err)
 	}
@@ -59,6 +70,15 @@ func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Failed to get Network View : %s", err)
 	}
+	if obj == nil {
+		log.Printf("[WARN] %s: network view not found, removing it from state", resourceNetworkViewIDString(d))
+		d.SetId("")
+		return nil
+	}
+	d.Set("network_view_name", obj.Name)
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
 	d.SetId(obj.Name)
 
 	log.Printf("[DEBUG] %s: got Network View", resourceNetworkViewIDString(d))
@@ -66,14 +86,47 @@ func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning network view update", resourceNetworkViewIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	Connector := m.(*ibclient.Connector)
+	objMgr := ibclient.NewObjectManager(Connector, "Terraform", tenantID)
 
-	return fmt.Errorf("network view updation is not supported")
+	obj, err := objMgr.GetNetworkView(d.Id())
+	if err != nil {
+		return fmt.Errorf("Failed to get Network View : %s", err)
+	}
+	if obj == nil {
+		return fmt.Errorf("Network View %s not found", d.Id())
+	}
+
+	addEA, removeEA := extAttrsChange(d)
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = tenantID
+	}
+	if err := objMgr.UpdateNetworkViewEA(obj.Ref, addEA, removeEA); err != nil {
+		return fmt.Errorf("Failed to update Network View : %s", err)
+	}
+
+	log.Printf("[DEBUG] %s: Completed network view update", resourceNetworkViewIDString(d))
+
+	return resourceNetworkViewRead(d, m)
 }
 func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
 
+// resourceNetworkViewImport accepts either a WAPI reference or the name of
+// the network view as the import ID.
+func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if networkView := ibclient.BuildNetworkViewFromRef(d.Id()); networkView != nil {
+		d.SetId(networkView.Name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourceNetworkViewIDStringInterface interface {
 	Id() string
 }
@@ -0,0 +1,220 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+// NS records carry no extensible attributes in NIOS, so unlike the other
+// record resources infoblox_ns_record has neither ext_attrs nor tenant_id.
+func resourceNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNSRecordCreate,
+		Read:   resourceNSRecordGet,
+		Update: resourceNSRecordUpdate,
+		Delete: resourceNSRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNSRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the zone the NS record belongs to.",
+			},
+			"nameserver": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Host name of the name server.",
+			},
+			"addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Addresses of the name server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address of the name server.",
+						},
+						"auto_create_ptr": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Create a PTR record for the address.",
+						},
+					},
+				},
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+		},
+	}
+}
+
+// zoneNameServers converts an addresses list to name server addresses.
+func zoneNameServers(addresses []interface{}) []ibclient.ZoneNameServer {
+	res := []ibclient.ZoneNameServer{}
+	for _, v := range addresses {
+		address := v.(map[string]interface{})
+		autoCreatePtr := address["auto_create_ptr"].(bool)
+		res = append(res, ibclient.ZoneNameServer{
+			Address:       address["address"].(string),
+			AutoCreatePtr: &autoCreatePtr,
+		})
+	}
+	return res
+}
+
+// zoneNameServersForState converts name server addresses returned by NIOS to
+// an addresses list.
+func zoneNameServersForState(addresses []ibclient.ZoneNameServer) []interface{} {
+	res := make([]interface{}, 0, len(addresses))
+	for _, address := range addresses {
+		res = append(res, map[string]interface{}{
+			"address":         address.Address,
+			"auto_create_ptr": address.AutoCreatePtr == nil || *address.AutoCreatePtr,
+		})
+	}
+	return res
+}
+
+func resourceNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create NS record", resourceNSRecordIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	recordNS, err := objMgr.CreateNSRecord(ibclient.RecordNS{
+		Name:       fqdn,
+		Nameserver: d.Get("nameserver").(string),
+		Addresses:  zoneNameServers(d.Get("addresses").([]interface{})),
+		View:       dnsView,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating NS Record (%s) in dns view (%s): %s", fqdn, dnsView, err)
+	}
+	d.SetId(recordNS.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of NS Record complete", resourceNSRecordIDString(d))
+	return resourceNSRecordGet(d, m)
+}
+
+func resourceNSRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get NS Record", resourceNSRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	obj, err := objMgr.GetNSRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: NS Record not found, removing it from state", resourceNSRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting NS record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Name)
+	d.Set("nameserver", obj.Nameserver)
+	if err := d.Set("addresses", zoneNameServersForState(obj.Addresses)); err != nil {
+		return err
+	}
+	d.Set("dns_view", obj.View)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required NS Record ", resourceNSRecordIDString(d))
+	return nil
+}
+
+func resourceNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of NS Record", resourceNSRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	_, err := objMgr.UpdateNSRecord(d.Id(), ibclient.RecordNS{
+		Addresses: zoneNameServers(d.Get("addresses").([]interface{})),
+	})
+	if err != nil {
+		return fmt.Errorf("Updating NS Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of NS Record complete", resourceNSRecordIDString(d))
+	return resourceNSRecordGet(d, m)
+}
+
+func resourceNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of NS Record", resourceNSRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	_, err := objMgr.DeleteNSRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of NS Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of NS Record complete", resourceNSRecordIDString(d))
+	return nil
+}
+
+// resourceNSRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn>/<nameserver> as the import ID, as a zone usually has
+// several NS records.
+func resourceNSRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:ns") {
+		dnsView, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		fqdn, nameserver, err := splitImportID(key)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid import ID (%s): expected a WAPI reference or <dns_view>/<fqdn>/<nameserver>", d.Id())
+		}
+		search := ibclient.NewRecordNS(ibclient.RecordNS{View: dnsView, Name: fqdn, Nameserver: nameserver})
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceNSRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceNSRecordIDString(d resourceNSRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_ns_record (ID = %s)", id)
+}
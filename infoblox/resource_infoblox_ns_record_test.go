@@ -0,0 +1,145 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceNSRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNSRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceNSRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSRecordExists(t, "infoblox_ns_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "fqdn", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "nameserver", "ns-ext.b.com"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "addresses.0.address", "10.0.0.53"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceNSRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSRecordExists(t, "infoblox_ns_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "addresses.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "addresses.1.address", "10.0.1.53"),
+					resource.TestCheckResourceAttr("infoblox_ns_record.foo", "addresses.1.auto_create_ptr", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_ns_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/a.com/ns-ext.b.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNSRecordMapping(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNSRecord().Schema, map[string]interface{}{
+		"fqdn":       "a.com",
+		"nameserver": "ns1.a.com",
+		"addresses": []interface{}{
+			map[string]interface{}{"address": "10.0.0.53"},
+			map[string]interface{}{"address": "10.0.1.53", "auto_create_ptr": false},
+		},
+	})
+	connector, requestor := testConnector(
+		`"record:ns/ZG5z:a.com/ns1.a.com/default"`,
+		`{"_ref": "record:ns/ZG5z:a.com/ns1.a.com/default", "name": "a.com", "nameserver": "ns1.a.com",
+		  "view": "default", "addresses": [{"address": "10.0.0.53", "auto_create_ptr": false}]}`,
+	)
+
+	if err := resourceNSRecordCreate(d, connector); err != nil {
+		t.Fatalf("resourceNSRecordCreate returned error %v", err)
+	}
+
+	obj := requestor.requests[0].object(t)
+	if obj["name"] != "a.com" || obj["nameserver"] != "ns1.a.com" || obj["view"] != "default" {
+		t.Fatalf("create request sent %v", obj)
+	}
+	addresses := obj["addresses"].([]interface{})
+	if len(addresses) != 2 ||
+		addresses[0].(map[string]interface{})["auto_create_ptr"] != true ||
+		addresses[1].(map[string]interface{})["auto_create_ptr"] != false ||
+		addresses[1].(map[string]interface{})["address"] != "10.0.1.53" {
+		t.Fatalf("create request sent addresses %v", addresses)
+	}
+
+	if d.Get("addresses.#") != 1 || d.Get("addresses.0.address") != "10.0.0.53" || d.Get("addresses.0.auto_create_ptr") != false {
+		t.Fatalf("state has addresses %v", d.Get("addresses"))
+	}
+}
+
+func testAccCheckNSRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetNSRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("NS record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccNSRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetNSRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("NS record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceNSRecordCreate = fmt.Sprintf(`
+resource "infoblox_ns_record" "foo"{
+	fqdn="a.com"
+	nameserver="ns-ext.b.com"
+	addresses {
+		address="10.0.0.53"
+	}
+	}`)
+
+var testAccresourceNSRecordUpdate = fmt.Sprintf(`
+resource "infoblox_ns_record" "foo"{
+	fqdn="a.com"
+	nameserver="ns-ext.b.com"
+	addresses {
+		address="10.0.0.53"
+	}
+	addresses {
+		address="10.0.1.53"
+		auto_create_ptr=false
+	}
+	}`)
@@ -14,12 +14,22 @@ func resourcePTRRecord() *schema.Resource {
 		Read:   resourcePTRRecordGet,
 		Update: resourcePTRRecordUpdate,
 		Delete: resourcePTRRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePTRRecordImport,
+		},
+		CustomizeDiff: recordNameCustomizeDiff("vm_name"),
 
 		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vm_name", "zone"},
+				Description:   "Domain name the PTR record points to. Alternative to vm_name and zone.",
+			},
 			"vm_name": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the VM.",
+				Optional:    true,
+				Description: "The name of the VM, used as the record name in zone. Also stored in the VM Name extensible attribute.",
 			},
 			"cidr": &schema.Schema{
 				Type:        schema.TypeString,
@@ -28,25 +38,50 @@ func resourcePTRRecord() *schema.Resource {
 			},
 			"zone": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Zone under which record has to be created.",
 			},
 			"dns_view": &schema.Schema{
 				Type:        schema.TypeString,
 				Default:     "default",
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Dns View under which the zone has been created.",
 			},
 			"ip_addr": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "IP address your instance in cloud. For static allocation, set the field with valid IP. For dynamic allocation, leave this field empty and set the cidr field.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "IP address your instance in cloud. For static allocation, set the field with valid IP. For dynamic allocation, leave this field empty and set the cidr field.",
+				DiffSuppressFunc: suppressDynamicIPDiff,
 			},
 			"vm_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "instance id.",
 			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the PTR record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the PTR record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the PTR record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the PTR record.",
+			},
 			"tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -59,20 +94,19 @@ func resourcePTRRecord() *schema.Resource {
 func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning to create PTR record from  required network block", resourcePTRRecordIDString(d))
 
-	//This is for record Name
-	recordName := d.Get("vm_name").(string)
 	ipAddr := d.Get("ip_addr").(string)
 	cidr := d.Get("cidr").(string)
 	vmID := d.Get("vm_id").(string)
 	vmName := d.Get("vm_name").(string)
-	zone := d.Get("zone").(string)
 	dnsView := d.Get("dns_view").(string)
 	tenantID := d.Get("tenant_id").(string)
 	connector := m.(*ibclient.Connector)
 
-	ea := make(ibclient.EA)
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
 
-	ea["VM Name"] = vmName
+	if vmName != "" {
+		ea["VM Name"] = vmName
+	}
 
 	if vmID != "" {
 		ea["VM ID"] = vmID
@@ -83,9 +117,23 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-	//fqdn
-	name := recordName + "." + zone
-	recordPTR, err := objMgr.CreatePTRRecord(dnsView, dnsView, name, cidr, ipAddr, ea)
+	name, err := vmRecordFQDN(d)
+	if err != nil {
+		return fmt.Errorf("Error creating PTR record: %s", err)
+	}
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordPTR, err := objMgr.CreatePTRRecordObject(ibclient.RecordPTR{
+		PtrdName: name,
+		View:     dnsView,
+		Ipv4Addr: ipAddrForCreate(d, dnsView),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       ea,
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating PTR Record from network block(%s): %s", cidr, err)
 	}
@@ -108,16 +156,81 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 
 	obj, err := objMgr.GetPTRRecordByRef(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: PTR Record not found, removing it from state", resourcePTRRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Getting PTR Record from dns view (%s) failed : %s", dnsView, err)
 	}
+	// The zone of a PTR record is the reverse zone, so the forward zone is
+	// taken from the PTR domain name.
+	if !usesFQDNArg(d, "vm_name") {
+		recordName, zone := splitRecordName(obj.PtrdName, d.Get("zone").(string))
+		d.Set("vm_name", recordName)
+		d.Set("zone", zone)
+	} else {
+		d.Set("fqdn", obj.PtrdName)
+	}
+	d.Set("dns_view", obj.View)
+	d.Set("ip_addr", obj.Ipv4Addr)
+	d.Set("vm_id", getEAValue(obj.Ea, "VM ID"))
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
 	d.SetId(obj.Ref)
 	log.Printf("[DEBUG] %s: Completed reading required PTR Record ", resourcePTRRecordIDString(d))
 	return nil
 }
 
 func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of PTR Record", resourcePTRRecordIDString(d))
+
+	vmID := d.Get("vm_id").(string)
+	vmName := d.Get("vm_name").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
 
-	return fmt.Errorf("updating a PTR record is not supported")
+	ea := eaFromExtAttrs(d.Get("ext_attrs"))
+
+	if vmName != "" {
+		ea["VM Name"] = vmName
+	}
+
+	if vmID != "" {
+		ea["VM ID"] = vmID
+	}
+
+	name, err := vmRecordFQDN(d)
+	if err != nil {
+		return fmt.Errorf("Updating PTR Record failed: %s", err)
+	}
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordPTR := ibclient.RecordPTR{
+		PtrdName: name,
+		Ipv4Addr: ipAddrForUpdate(d, dnsView),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       ea,
+	}
+	_, err = objMgr.UpdatePTRRecord(d.Id(), recordPTR)
+	if err != nil {
+		return fmt.Errorf("Updating PTR Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of PTR Record complete", resourcePTRRecordIDString(d))
+	return resourcePTRRecordGet(d, m)
 }
 
 func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
@@ -139,6 +252,31 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourcePTRRecordImport accepts either a WAPI reference, <dns_view>/<fqdn>
+// where fqdn is the domain name the record points to, or <dns_view>/<ip_addr>
+// as the import ID.
+func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:ptr") {
+		dnsView, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		search := ibclient.RecordPTR{View: dnsView, PtrdName: key}
+		if isIPAddress(key) {
+			search = ibclient.RecordPTR{View: dnsView, Ipv4Addr: key}
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordPTR(search), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type resourcePTRRecordIDStringInterface interface {
 	Id() string
 }
@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -35,10 +36,84 @@ func TestAccResourcePTRRecord(t *testing.T) {
 					testAccPTRRecordExists(t, "infoblox_ptr_record.foo", "10.0.0.0/24", "10.0.0.2", "test", "demo-network", "default", "a.com"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccresourcePTRRecordUpdateInPlace,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ptr_record.foo", "ip_addr", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_ptr_record.foo", "vm_name", "test-name2"),
+					resource.TestCheckResourceAttr("infoblox_ptr_record.foo", "comment", "cutover"),
+					resource.TestCheckResourceAttr("infoblox_ptr_record.foo", "disable", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_ptr_record.foo",
+				ImportState:             true,
+				ImportStateId:           "default/10.0.0.2",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePTRRecordFQDN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPTRRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourcePTRRecordFQDN,
+				Check: resource.ComposeTestCheckFunc(
+					testAccPTRRecordExists(t, "infoblox_ptr_record.fqdn", "", "10.0.0.5", "", "", "default", ""),
+					resource.TestCheckResourceAttr("infoblox_ptr_record.fqdn", "fqdn", "mail.a.com"),
+					resource.TestCheckResourceAttr("infoblox_ptr_record.fqdn", "ip_addr", "10.0.0.5"),
+				),
+			},
 		},
 	})
 }
 
+func TestPTRRecordUpdateMapping(t *testing.T) {
+	d := testResourceDataUpdate(t, resourcePTRRecord(), map[string]string{
+		"vm_name":   "web",
+		"zone":      "a.com",
+		"dns_view":  "default",
+		"ip_addr":   "10.0.0.5",
+		"disable":   "false",
+		"tenant_id": "foo",
+	}, map[string]interface{}{
+		"vm_name":   "db",
+		"zone":      "a.com",
+		"ip_addr":   "10.0.0.6",
+		"comment":   "moved",
+		"tenant_id": "foo",
+	})
+	d.SetId("record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default")
+	connector, requestor := testConnector(
+		`"record:ptr/ZG5z:6.0.0.10.in-addr.arpa/default"`,
+		`{"_ref": "record:ptr/ZG5z:6.0.0.10.in-addr.arpa/default", "ptrdname": "db.a.com", "zone": "a.com",
+		  "view": "default", "ipv4addr": "10.0.0.6", "comment": "moved"}`,
+	)
+
+	if err := resourcePTRRecordUpdate(d, connector); err != nil {
+		t.Fatalf("resourcePTRRecordUpdate returned error %v", err)
+	}
+
+	req := requestor.requests[0]
+	if req.method != "PUT" || !strings.Contains(req.url, "/record:ptr/ZG5z:5.0.0.10.in-addr.arpa/default") {
+		t.Fatalf("update sent %s %s", req.method, req.url)
+	}
+	obj := req.object(t)
+	if obj["ptrdname"] != "db.a.com" || obj["ipv4addr"] != "10.0.0.6" || obj["comment"] != "moved" || obj["use_ttl"] != false {
+		t.Fatalf("update sent %v", obj)
+	}
+
+	if d.Id() != "record:ptr/ZG5z:6.0.0.10.in-addr.arpa/default" || d.Get("vm_name") != "db" || d.Get("ip_addr") != "10.0.0.6" {
+		t.Fatalf("state has ID %s, vm_name %v and ip_addr %v", d.Id(), d.Get("vm_name"), d.Get("ip_addr"))
+	}
+}
+
 func testAccCheckPTRRecordDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 
@@ -111,3 +186,22 @@ resource "infoblox_ptr_record" "foo"{
 	ip_addr="10.0.0.2"
 	tenant_id="foo"
 	}`)
+
+var testAccresourcePTRRecordUpdateInPlace = fmt.Sprintf(`
+resource "infoblox_ptr_record" "foo"{
+	vm_name="test-name2"
+	dns_view="default"
+	zone="a.com"
+	cidr="10.0.0.0/24"
+	ip_addr="10.0.0.2"
+	comment="cutover"
+	disable=true
+	tenant_id="foo"
+	}`)
+
+var testAccresourcePTRRecordFQDN = fmt.Sprintf(`
+resource "infoblox_ptr_record" "fqdn"{
+	fqdn="mail.a.com"
+	ip_addr="10.0.0.5"
+	tenant_id="foo"
+	}`)
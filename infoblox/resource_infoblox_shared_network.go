@@ -0,0 +1,268 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceSharedNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedNetworkCreate,
+		Read:   resourceSharedNetworkRead,
+		Update: resourceSharedNetworkUpdate,
+		Delete: resourceSharedNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSharedNetworkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the shared network.",
+			},
+			"network_view_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Network view of the shared network. Defaults to the network view of the networks.",
+			},
+			"networks": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the infoblox_network resources in the shared network, all in the same network view.",
+			},
+			"dhcp_option": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionResource(),
+				Description: "DHCP options sent to the clients of the shared network.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the shared network.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the shared network without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the shared network.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+// sharedNetworkView returns the network view of the networks given by their
+// references. NIOS requires all networks of a shared network to be in the
+// same network view.
+func sharedNetworkView(networks []interface{}) (string, error) {
+	netview := ""
+	for _, v := range networks {
+		network := ibclient.BuildNetworkFromRef(v.(string))
+		if network == nil {
+			return "", fmt.Errorf("%q is not a reference to a network", v.(string))
+		}
+		if netview != "" && network.NetviewName != netview {
+			return "", fmt.Errorf("all networks must be in the same network view, got %s and %s", netview, network.NetviewName)
+		}
+		netview = network.NetviewName
+	}
+	return netview, nil
+}
+
+// networkRefs converts a networks list to network references.
+func networkRefs(networks []interface{}) []ibclient.NetworkRef {
+	res := []ibclient.NetworkRef{}
+	for _, v := range networks {
+		res = append(res, ibclient.NetworkRef{Ref: v.(string)})
+	}
+	return res
+}
+
+// networkRefsForState converts network references returned by NIOS to a
+// networks list.
+func networkRefsForState(refs []ibclient.NetworkRef) []interface{} {
+	res := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		res = append(res, ref.Ref)
+	}
+	return res
+}
+
+// buildSharedNetwork returns the settings of the shared network which can be
+// changed after creation. The networks are checked to be in the network view
+// of the shared network.
+func buildSharedNetwork(d *schema.ResourceData) (ibclient.SharedNetwork, error) {
+	networks := d.Get("networks").([]interface{})
+	netview, err := sharedNetworkView(networks)
+	if err != nil {
+		return ibclient.SharedNetwork{}, err
+	}
+	if networkViewName := d.Get("network_view_name").(string); networkViewName != "" && netview != networkViewName {
+		return ibclient.SharedNetwork{}, fmt.Errorf("the networks are in network view %s, not in %s", netview, networkViewName)
+	}
+
+	options := dhcpOptions(d.Get("dhcp_option").([]interface{}))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+
+	return ibclient.SharedNetwork{
+		Name:        d.Get("name").(string),
+		NetviewName: netview,
+		Networks:    networkRefs(networks),
+		Options:     &options,
+		Comment:     &comment,
+		Disable:     &disable,
+		Ea:          eaFromExtAttrs(d.Get("ext_attrs")),
+	}, nil
+}
+
+func resourceSharedNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create shared network", resourceSharedNetworkIDString(d))
+
+	name := d.Get("name").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	sn, err := buildSharedNetwork(d)
+	if err != nil {
+		return fmt.Errorf("Creation of shared network (%s) failed : %s", name, err)
+	}
+	sharedNetwork, err := objMgr.CreateSharedNetwork(sn)
+	if err != nil {
+		return fmt.Errorf("Creation of shared network (%s) failed in network view (%s) : %s", name, sn.NetviewName, err)
+	}
+	d.SetId(sharedNetwork.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of shared network complete", resourceSharedNetworkIDString(d))
+	return resourceSharedNetworkRead(d, m)
+}
+
+func resourceSharedNetworkRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required shared network", resourceSharedNetworkIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetSharedNetworkByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: shared network not found, removing it from state", resourceSharedNetworkIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting shared network (%s) failed : %s", d.Id(), err)
+	}
+	d.Set("name", obj.Name)
+	d.Set("network_view_name", obj.NetviewName)
+	if err := d.Set("networks", networkRefsForState(obj.Networks)); err != nil {
+		return err
+	}
+	if err := d.Set("dhcp_option", dhcpOptionsForState(d.Get("dhcp_option").([]interface{}), obj.Options)); err != nil {
+		return err
+	}
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading shared network", resourceSharedNetworkIDString(d))
+	return nil
+}
+
+func resourceSharedNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of shared network", resourceSharedNetworkIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	sn, err := buildSharedNetwork(d)
+	if err != nil {
+		return fmt.Errorf("Update of shared network (%s) failed : %s", d.Id(), err)
+	}
+	// The reference of the shared network changes when it is renamed.
+	sharedNetwork, err := objMgr.UpdateSharedNetwork(d.Id(), sn)
+	if err != nil {
+		return fmt.Errorf("Update of shared network (%s) failed : %s", d.Id(), err)
+	}
+	d.SetId(sharedNetwork.Ref)
+
+	log.Printf("[DEBUG] %s: Update of shared network complete", resourceSharedNetworkIDString(d))
+	return resourceSharedNetworkRead(d, m)
+}
+
+func resourceSharedNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of shared network", resourceSharedNetworkIDString(d))
+
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteSharedNetwork(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of shared network (%s) failed : %s", d.Id(), err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of shared network complete", resourceSharedNetworkIDString(d))
+	return nil
+}
+
+// resourceSharedNetworkImport accepts either a WAPI reference or
+// <network_view>/<name> as the import ID.
+func resourceSharedNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "sharednetwork") {
+		networkViewName, name, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewSharedNetwork(ibclient.SharedNetwork{NetviewName: networkViewName, Name: name}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceSharedNetworkIDStringInterface interface {
+	Id() string
+}
+
+func resourceSharedNetworkIDString(d resourceSharedNetworkIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_shared_network (ID = %s)", id)
+}
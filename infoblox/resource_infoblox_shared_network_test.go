@@ -0,0 +1,149 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceSharedNetwork(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedNetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceSharedNetworkCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedNetworkExists(t, "infoblox_shared_network.foo"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "network_view_name", "default"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "networks.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceSharedNetworkUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedNetworkExists(t, "infoblox_shared_network.foo"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "name", "vlan-20"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "networks.#", "3"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "comment", "updated in place"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "disable", "true"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "dhcp_option.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_shared_network.foo", "dhcp_option.0.num", "15"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_shared_network.foo",
+				ImportState:       true,
+				ImportStateId:     "default/vlan-20",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSharedNetworkView(t *testing.T) {
+	cases := []struct {
+		networks []interface{}
+		expected string
+		err      bool
+	}{
+		{[]interface{}{"network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"}, "default", false},
+		{[]interface{}{
+			"network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default",
+			"network/ZG5zLm5ldHdvcmskMTAuMC4xLjAvMjQvMA:10.0.1.0/24/default",
+		}, "default", false},
+		{[]interface{}{
+			"network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default",
+			"network/ZG5zLm5ldHdvcmskMTAuMC4xLjAvMjQvMQ:10.0.1.0/24/lab",
+		}, "", true},
+		{[]interface{}{"ipv6network/ZG5zLm5ldHdvcmskMjAwMTpkYjg6Oi82NC8w:2001%3Adb8%3A%3A/64/default"}, "", true},
+	}
+
+	for _, tc := range cases {
+		res, err := sharedNetworkView(tc.networks)
+		if (err != nil) != tc.err {
+			t.Fatalf("sharedNetworkView(%v) returned error %v", tc.networks, err)
+		}
+		if res != tc.expected {
+			t.Fatalf("sharedNetworkView(%v) returned %q, expected %q", tc.networks, res, tc.expected)
+		}
+	}
+}
+
+func testAccCheckSharedNetworkDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_shared_network" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetSharedNetworkByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Shared network still exists")
+		}
+	}
+	return nil
+}
+
+func testAccSharedNetworkExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetSharedNetworkByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Shared network not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceSharedNetworkNetworks = `
+resource "infoblox_network" "net1"{
+	cidr="10.40.1.0/24"
+	tenant_id="foo"
+	}
+resource "infoblox_network" "net2"{
+	cidr="10.40.2.0/24"
+	tenant_id="foo"
+	}
+resource "infoblox_network" "net3"{
+	cidr="10.40.3.0/24"
+	tenant_id="foo"
+	}`
+
+var testAccresourceSharedNetworkCreate = fmt.Sprintf(`%s
+resource "infoblox_shared_network" "foo"{
+	name="vlan-10"
+	networks=[infoblox_network.net1.id, infoblox_network.net2.id]
+	tenant_id="foo"
+	}`, testAccresourceSharedNetworkNetworks)
+
+var testAccresourceSharedNetworkUpdate = fmt.Sprintf(`%s
+resource "infoblox_shared_network" "foo"{
+	name="vlan-20"
+	networks=[infoblox_network.net1.id, infoblox_network.net2.id, infoblox_network.net3.id]
+	comment="updated in place"
+	disable=true
+	dhcp_option {
+		name="domain-name"
+		value="lab.example.com"
+	}
+	tenant_id="foo"
+	}`, testAccresourceSharedNetworkNetworks)
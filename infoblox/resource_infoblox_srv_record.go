@@ -0,0 +1,249 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceSRVRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSRVRecordCreate,
+		Read:   resourceSRVRecordGet,
+		Update: resourceSRVRecordUpdate,
+		Delete: resourceSRVRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSRVRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service name of the SRV record, e.g. _ldap._tcp.example.com.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+				Description:  "Priority of the target host, lower values are preferred.",
+			},
+			"weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+				Description:  "Relative weight of targets with the same priority.",
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+				Description:  "Port of the service on the target host.",
+			},
+			"target": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name of the target providing the service.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the SRV record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the SRV record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the SRV record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the SRV record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+// buildSRVRecord returns the SRV record described by the arguments in d,
+// without the DNS view which cannot be updated.
+func buildSRVRecord(d *schema.ResourceData) ibclient.RecordSRV {
+	priority := uint(d.Get("priority").(int))
+	weight := uint(d.Get("weight").(int))
+	port := uint(d.Get("port").(int))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.RecordSRV{
+		Name:     d.Get("fqdn").(string),
+		Priority: &priority,
+		Weight:   &weight,
+		Port:     &port,
+		Target:   d.Get("target").(string),
+		Ttl:      ttl,
+		UseTtl:   useTTL,
+		Comment:  &comment,
+		Disable:  &disable,
+		Ea:       eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create SRV record", resourceSRVRecordIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordSRV := buildSRVRecord(d)
+	recordSRV.View = dnsView
+	obj, err := objMgr.CreateSRVRecord(recordSRV)
+	if err != nil {
+		return fmt.Errorf("Error creating SRV Record (%s) in dns view (%s): %s", fqdn, dnsView, err)
+	}
+	d.SetId(obj.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of SRV Record complete", resourceSRVRecordIDString(d))
+	return resourceSRVRecordGet(d, m)
+}
+
+func resourceSRVRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get SRV Record", resourceSRVRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetSRVRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: SRV Record not found, removing it from state", resourceSRVRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting SRV record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Name)
+	d.Set("dns_view", obj.View)
+	if obj.Priority != nil {
+		d.Set("priority", int(*obj.Priority))
+	}
+	if obj.Weight != nil {
+		d.Set("weight", int(*obj.Weight))
+	}
+	if obj.Port != nil {
+		d.Set("port", int(*obj.Port))
+	}
+	d.Set("target", obj.Target)
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required SRV Record ", resourceSRVRecordIDString(d))
+	return nil
+}
+
+func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of SRV Record", resourceSRVRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateSRVRecord(d.Id(), buildSRVRecord(d))
+	if err != nil {
+		return fmt.Errorf("Updating SRV Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of SRV Record complete", resourceSRVRecordIDString(d))
+	return resourceSRVRecordGet(d, m)
+}
+
+func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of SRV Record", resourceSRVRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteSRVRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of SRV Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of SRV Record complete", resourceSRVRecordIDString(d))
+	return nil
+}
+
+// resourceSRVRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn>/<target> as the import ID, as a service usually has
+// several SRV records.
+func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:srv") {
+		dnsView, key, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		fqdn, target, err := splitImportID(key)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid import ID (%s): expected a WAPI reference or <dns_view>/<fqdn>/<target>", d.Id())
+		}
+		search := ibclient.NewRecordSRV(ibclient.RecordSRV{View: dnsView, Name: fqdn, Target: target})
+		ref, err := searchObjectRef(connector, search, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceSRVRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceSRVRecordIDString(d resourceSRVRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_srv_record (ID = %s)", id)
+}
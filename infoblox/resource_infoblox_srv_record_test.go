@@ -0,0 +1,113 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceSRVRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSRVRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceSRVRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSRVRecordExists(t, "infoblox_srv_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "fqdn", "_ldap._tcp.a.com"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "priority", "0"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "weight", "5"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "port", "389"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "target", "ldap1.a.com"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceSRVRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSRVRecordExists(t, "infoblox_srv_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "priority", "10"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "port", "636"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "target", "ldap2.a.com"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "ttl", "3600"),
+					resource.TestCheckResourceAttr("infoblox_srv_record.foo", "ext_attrs.Site", "HQ"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_srv_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/_ldap._tcp.a.com/ldap2.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSRVRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_srv_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetSRVRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("SRV record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccSRVRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetSRVRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("SRV record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceSRVRecordCreate = fmt.Sprintf(`
+resource "infoblox_srv_record" "foo"{
+	fqdn="_ldap._tcp.a.com"
+	priority=0
+	weight=5
+	port=389
+	target="ldap1.a.com"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceSRVRecordUpdate = fmt.Sprintf(`
+resource "infoblox_srv_record" "foo"{
+	fqdn="_ldap._tcp.a.com"
+	priority=10
+	weight=5
+	port=636
+	target="ldap2.a.com"
+	ttl=3600
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,245 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceTLSARecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTLSARecordCreate,
+		Read:   resourceTLSARecordGet,
+		Update: resourceTLSARecordUpdate,
+		Delete: resourceTLSARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTLSARecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the record relative to the zone, e.g. _443._tcp.www.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Zone under which record has to be created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"certificate_usage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 3),
+				Description:  "How the certificate is matched: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE).",
+			},
+			"selector": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 1),
+				Description:  "The part of the certificate matched: 0 (full certificate) or 1 (public key).",
+			},
+			"matched_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 2),
+				Description:  "How certificate_data is presented: 0 (exact match), 1 (SHA-256 hash) or 2 (SHA-512 hash).",
+			},
+			"certificate_data": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateHexData,
+				DiffSuppressFunc: suppressCaseDiff,
+				Description:      "The certificate association data in hex format.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the TLSA record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the TLSA record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the TLSA record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the TLSA record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+// buildTLSARecord returns the TLSA record described by the arguments in d,
+// without the DNS view which cannot be updated.
+func buildTLSARecord(d *schema.ResourceData) ibclient.RecordTLSA {
+	certificateUsage := uint(d.Get("certificate_usage").(int))
+	selector := uint(d.Get("selector").(int))
+	matchedType := uint(d.Get("matched_type").(int))
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.RecordTLSA{
+		Name:             recordFQDN(d.Get("name").(string), d.Get("zone").(string)),
+		CertificateUsage: &certificateUsage,
+		Selector:         &selector,
+		MatchedType:      &matchedType,
+		CertificateData:  d.Get("certificate_data").(string),
+		Ttl:              ttl,
+		UseTtl:           useTTL,
+		Comment:          &comment,
+		Disable:          &disable,
+		Ea:               eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceTLSARecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create TLSA record", resourceTLSARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordTLSA := buildTLSARecord(d)
+	recordTLSA.View = dnsView
+	obj, err := objMgr.CreateTLSARecord(recordTLSA)
+	if err != nil {
+		return fmt.Errorf("Error creating TLSA Record (%s) in dns view (%s): %s", recordTLSA.Name, dnsView, err)
+	}
+	d.SetId(obj.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of TLSA Record complete", resourceTLSARecordIDString(d))
+	return resourceTLSARecordGet(d, m)
+}
+
+func resourceTLSARecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get TLSA Record", resourceTLSARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetTLSARecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: TLSA Record not found, removing it from state", resourceTLSARecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting TLSA record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("name", relativeRecordName(obj.Name, obj.Zone))
+	d.Set("zone", obj.Zone)
+	d.Set("dns_view", obj.View)
+	d.Set("certificate_usage", int(uintValue(obj.CertificateUsage)))
+	d.Set("selector", int(uintValue(obj.Selector)))
+	d.Set("matched_type", int(uintValue(obj.MatchedType)))
+	d.Set("certificate_data", obj.CertificateData)
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required TLSA Record ", resourceTLSARecordIDString(d))
+	return nil
+}
+
+func resourceTLSARecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of TLSA Record", resourceTLSARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateTLSARecord(d.Id(), buildTLSARecord(d))
+	if err != nil {
+		return fmt.Errorf("Updating TLSA Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of TLSA Record complete", resourceTLSARecordIDString(d))
+	return resourceTLSARecordGet(d, m)
+}
+
+func resourceTLSARecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of TLSA Record", resourceTLSARecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteTLSARecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of TLSA Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of TLSA Record complete", resourceTLSARecordIDString(d))
+	return nil
+}
+
+// resourceTLSARecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID. A name with several TLSA records can
+// only be imported by WAPI reference.
+func resourceTLSARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:tlsa") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordTLSA(ibclient.RecordTLSA{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceTLSARecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceTLSARecordIDString(d resourceTLSARecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_tlsa_record (ID = %s)", id)
+}
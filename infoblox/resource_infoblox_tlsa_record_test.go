@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceTLSARecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTLSARecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceTLSARecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTLSARecordExists(t, "infoblox_tlsa_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "name", "_443._tcp.www"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "zone", "a.com"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "certificate_usage", "3"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "selector", "1"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "matched_type", "1"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceTLSARecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTLSARecordExists(t, "infoblox_tlsa_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "certificate_usage", "2"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "selector", "0"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.foo", "ext_attrs.Site", "HQ"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_tlsa_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/_443._tcp.www.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTLSARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_tlsa_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetTLSARecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("TLSA record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccTLSARecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetTLSARecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("TLSA record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceTLSARecordCreate = fmt.Sprintf(`
+resource "infoblox_tlsa_record" "foo"{
+	name="_443._tcp.www"
+	zone="a.com"
+	certificate_usage=3
+	selector=1
+	matched_type=1
+	certificate_data="0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceTLSARecordUpdate = fmt.Sprintf(`
+resource "infoblox_tlsa_record" "foo"{
+	name="_443._tcp.www"
+	zone="a.com"
+	certificate_usage=2
+	selector=0
+	matched_type=1
+	certificate_data="0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
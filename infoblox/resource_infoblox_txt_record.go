@@ -0,0 +1,213 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceTXTRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTXTRecordCreate,
+		Read:   resourceTXTRecordGet,
+		Update: resourceTXTRecordUpdate,
+		Delete: resourceTXTRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTXTRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Fully qualified domain name of the TXT record.",
+			},
+			"text": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Text of the TXT record, e.g. an SPF policy or a domain validation token.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the TXT record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the TXT record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the TXT record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the TXT record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create TXT record", resourceTXTRecordIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	recordTXT, err := objMgr.CreateTXTRecord(ibclient.RecordTXT{
+		Name:    fqdn,
+		Text:    d.Get("text").(string),
+		View:    dnsView,
+		Ttl:     ttl,
+		UseTtl:  useTTL,
+		Comment: &comment,
+		Disable: &disable,
+		Ea:      eaFromExtAttrs(d.Get("ext_attrs")),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating TXT Record (%s) in dns view (%s): %s", fqdn, dnsView, err)
+	}
+	d.SetId(recordTXT.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of TXT Record complete", resourceTXTRecordIDString(d))
+	return resourceTXTRecordGet(d, m)
+}
+
+func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get TXT Record", resourceTXTRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetTXTRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: TXT Record not found, removing it from state", resourceTXTRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting TXT record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Name)
+	d.Set("text", obj.Text)
+	d.Set("dns_view", obj.View)
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required TXT Record ", resourceTXTRecordIDString(d))
+	return nil
+}
+
+func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of TXT Record", resourceTXTRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+	_, err := objMgr.UpdateTXTRecord(d.Id(), ibclient.RecordTXT{
+		Name:    d.Get("fqdn").(string),
+		Text:    d.Get("text").(string),
+		Ttl:     ttl,
+		UseTtl:  useTTL,
+		Comment: &comment,
+		Disable: &disable,
+		Ea:      eaFromExtAttrs(d.Get("ext_attrs")),
+	})
+	if err != nil {
+		return fmt.Errorf("Updating TXT Record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of TXT Record complete", resourceTXTRecordIDString(d))
+	return resourceTXTRecordGet(d, m)
+}
+
+func resourceTXTRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of TXT Record", resourceTXTRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteTXTRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of TXT Record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of TXT Record complete", resourceTXTRecordIDString(d))
+	return nil
+}
+
+// resourceTXTRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceTXTRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:txt") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordTXT(ibclient.RecordTXT{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceTXTRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceTXTRecordIDString(d resourceTXTRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_txt_record (ID = %s)", id)
+}
@@ -0,0 +1,104 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceTXTRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTXTRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceTXTRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTXTRecordExists(t, "infoblox_txt_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "fqdn", "txt.a.com"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "text", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceTXTRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTXTRecordExists(t, "infoblox_txt_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "text", "validation-token=abc123"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "ext_attrs.Site", "HQ"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "ttl", "60"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo", "comment", "domain validation"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_txt_record.foo",
+				ImportState:       true,
+				ImportStateId:     "default/txt.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTXTRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_txt_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetTXTRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("TXT record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccTXTRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetTXTRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("TXT record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceTXTRecordCreate = fmt.Sprintf(`
+resource "infoblox_txt_record" "foo"{
+	fqdn="txt.a.com"
+	text="v=spf1 mx -all"
+	tenant_id="foo"
+	}`)
+
+var testAccresourceTXTRecordUpdate = fmt.Sprintf(`
+resource "infoblox_txt_record" "foo"{
+	fqdn="txt.a.com"
+	text="validation-token=abc123"
+	ttl=60
+	comment="domain validation"
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,422 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceUnknownRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUnknownRecordCreate,
+		Read:   resourceUnknownRecordGet,
+		Update: resourceUnknownRecordUpdate,
+		Delete: resourceUnknownRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceUnknownRecordImport,
+		},
+		CustomizeDiff: resourceUnknownRecordCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the record relative to the zone. Leave empty for the zone apex.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Zone under which record has to be created.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone has been created.",
+			},
+			"record_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The DNS type of the record, e.g. SSHFP.",
+			},
+			"subfield_values": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"sshfp"},
+				Description:   "The fields of the record data in order. Required unless sshfp is set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSubfieldType,
+							Description:  "The type of the field: B, S or I for 8, 16 or 32 bit integers, H for base64, X for hex, T for text, N for a domain name, 4 or 6 for an IP address, P for presentation format.",
+						},
+						"field_value": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value of the field.",
+						},
+						"include_length": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NONE",
+							ValidateFunc: validateSubfieldIncludeLength,
+							Description:  "The size of the length prefix of the field: NONE, 8_BIT or 16_BIT.",
+						},
+					},
+				},
+			},
+			"sshfp": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"subfield_values"},
+				Description:   "The data of an SSHFP record, sent as subfield_values.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateSSHFPAlgorithm,
+							Description:  "The algorithm of the SSH key: 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448).",
+						},
+						"fingerprint_type": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntBetween(1, 2),
+							Description:  "The hash of the fingerprint: 1 (SHA-1) or 2 (SHA-256).",
+						},
+						"fingerprint": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateHexData,
+							DiffSuppressFunc: suppressCaseDiff,
+							Description:      "The fingerprint of the SSH key in hex format.",
+						},
+					},
+				},
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "TTL of the unknown record in seconds. The zone TTL is used when not set.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the unknown record.",
+			},
+			"disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the unknown record without deleting it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the unknown record.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func validateSubfieldType(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "B", "S", "I", "H", "X", "T", "N", "4", "6", "P":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of B, S, I, H, X, T, N, 4, 6 or P, got %q", k, v.(string)))
+	}
+	return
+}
+
+func validateSubfieldIncludeLength(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "NONE", "8_BIT", "16_BIT":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of NONE, 8_BIT or 16_BIT, got %q", k, v.(string)))
+	}
+	return
+}
+
+func validateSSHFPAlgorithm(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(int) {
+	case 1, 2, 3, 4, 6:
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of 1, 2, 3, 4 or 6, got %d", k, v.(int)))
+	}
+	return
+}
+
+// sshfpFingerprintLengths are the lengths of the hex encoded fingerprints by
+// fingerprint type.
+var sshfpFingerprintLengths = map[int]int{1: 40, 2: 64}
+
+// resourceUnknownRecordCustomizeDiff checks at plan time that an sshfp block
+// is only set for SSHFP records and that its fingerprint has the length of
+// its fingerprint type.
+func resourceUnknownRecordCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Get("sshfp").([]interface{})) == 0 {
+		return nil
+	}
+	if recordType := d.Get("record_type").(string); d.NewValueKnown("record_type") && recordType != "SSHFP" {
+		return fmt.Errorf("sshfp can only be set for SSHFP records, not %s records", recordType)
+	}
+	if !d.NewValueKnown("sshfp.0.fingerprint_type") || !d.NewValueKnown("sshfp.0.fingerprint") {
+		return nil
+	}
+	fingerprintType := d.Get("sshfp.0.fingerprint_type").(int)
+	fingerprint := d.Get("sshfp.0.fingerprint").(string)
+	if length, ok := sshfpFingerprintLengths[fingerprintType]; ok && len(fingerprint) != length {
+		return fmt.Errorf("the fingerprint of fingerprint type %d must be %d hex digits long, got %d", fingerprintType, length, len(fingerprint))
+	}
+	return nil
+}
+
+// sshfpSubfields converts an sshfp block to record fields.
+func sshfpSubfields(sshfp map[string]interface{}) []ibclient.UnknownRecordSubfield {
+	return []ibclient.UnknownRecordSubfield{
+		{FieldType: "B", FieldValue: strconv.Itoa(sshfp["algorithm"].(int)), IncludeLength: "NONE"},
+		{FieldType: "B", FieldValue: strconv.Itoa(sshfp["fingerprint_type"].(int)), IncludeLength: "NONE"},
+		{FieldType: "X", FieldValue: sshfp["fingerprint"].(string), IncludeLength: "NONE"},
+	}
+}
+
+// sshfpForState converts record fields returned by NIOS to an sshfp block,
+// which is empty when the fields are not those of an SSHFP record.
+func sshfpForState(subfields []ibclient.UnknownRecordSubfield) []interface{} {
+	if len(subfields) != 3 || subfields[0].FieldType != "B" || subfields[1].FieldType != "B" || subfields[2].FieldType != "X" {
+		return nil
+	}
+	algorithm, err := strconv.Atoi(subfields[0].FieldValue)
+	if err != nil {
+		return nil
+	}
+	fingerprintType, err := strconv.Atoi(subfields[1].FieldValue)
+	if err != nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"algorithm":        algorithm,
+		"fingerprint_type": fingerprintType,
+		"fingerprint":      subfields[2].FieldValue,
+	}}
+}
+
+// unknownRecordSubfields converts a subfield_values list to record fields.
+func unknownRecordSubfields(values []interface{}) []ibclient.UnknownRecordSubfield {
+	subfields := []ibclient.UnknownRecordSubfield{}
+	for _, v := range values {
+		value := v.(map[string]interface{})
+		subfields = append(subfields, ibclient.UnknownRecordSubfield{
+			FieldType:     value["field_type"].(string),
+			FieldValue:    value["field_value"].(string),
+			IncludeLength: value["include_length"].(string),
+		})
+	}
+	return subfields
+}
+
+// unknownRecordSubfieldsForState converts record fields returned by NIOS to
+// a subfield_values list.
+func unknownRecordSubfieldsForState(subfields []ibclient.UnknownRecordSubfield) []interface{} {
+	values := make([]interface{}, 0, len(subfields))
+	for _, subfield := range subfields {
+		includeLength := subfield.IncludeLength
+		if includeLength == "" {
+			includeLength = "NONE"
+		}
+		values = append(values, map[string]interface{}{
+			"field_type":     subfield.FieldType,
+			"field_value":    subfield.FieldValue,
+			"include_length": includeLength,
+		})
+	}
+	return values
+}
+
+// buildUnknownRecord returns the unknown record described by the arguments
+// in d, without the DNS view and the record type which cannot be updated.
+// The record data is taken from the sshfp block when it is set.
+func buildUnknownRecord(d *schema.ResourceData) (ibclient.RecordUnknown, error) {
+	var subfields []ibclient.UnknownRecordSubfield
+	if sshfp := d.Get("sshfp").([]interface{}); len(sshfp) > 0 && sshfp[0] != nil {
+		subfields = sshfpSubfields(sshfp[0].(map[string]interface{}))
+	} else if values := d.Get("subfield_values").([]interface{}); len(values) > 0 {
+		subfields = unknownRecordSubfields(values)
+	} else {
+		return ibclient.RecordUnknown{}, fmt.Errorf("one of subfield_values or sshfp must be set")
+	}
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTTL := recordTTL(d)
+
+	return ibclient.RecordUnknown{
+		Name:           recordFQDN(d.Get("name").(string), d.Get("zone").(string)),
+		SubfieldValues: subfields,
+		Ttl:            ttl,
+		UseTtl:         useTTL,
+		Comment:        &comment,
+		Disable:        &disable,
+		Ea:             eaFromExtAttrs(d.Get("ext_attrs")),
+	}, nil
+}
+
+func resourceUnknownRecordCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create unknown record", resourceUnknownRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordUnknown, err := buildUnknownRecord(d)
+	if err != nil {
+		return fmt.Errorf("Error creating unknown record: %s", err)
+	}
+	recordUnknown.View = dnsView
+	recordUnknown.RecordType = d.Get("record_type").(string)
+	obj, err := objMgr.CreateUnknownRecord(recordUnknown)
+	if err != nil {
+		return fmt.Errorf("Error creating unknown record (%s) in dns view (%s): %s", recordUnknown.Name, dnsView, err)
+	}
+	d.SetId(obj.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of unknown record complete", resourceUnknownRecordIDString(d))
+	return resourceUnknownRecordGet(d, m)
+}
+
+func resourceUnknownRecordGet(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Begining to Get unknown record", resourceUnknownRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetUnknownRecordByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: unknown record not found, removing it from state", resourceUnknownRecordIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting unknown record failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("name", relativeRecordName(obj.Name, obj.Zone))
+	d.Set("zone", obj.Zone)
+	d.Set("dns_view", obj.View)
+	d.Set("record_type", obj.RecordType)
+	if err := d.Set("subfield_values", unknownRecordSubfieldsForState(obj.SubfieldValues)); err != nil {
+		return err
+	}
+	// sshfp is only kept in state when it is used, as the fields of any
+	// SSHFP record would fill it.
+	if len(d.Get("sshfp").([]interface{})) > 0 {
+		if err := d.Set("sshfp", sshfpForState(obj.SubfieldValues)); err != nil {
+			return err
+		}
+	}
+	d.Set("ttl", ttlForState(obj.Ttl, obj.UseTtl))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("disable", boolValue(obj.Disable))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading required unknown record ", resourceUnknownRecordIDString(d))
+	return nil
+}
+
+func resourceUnknownRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of unknown record", resourceUnknownRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	recordUnknown, err := buildUnknownRecord(d)
+	if err != nil {
+		return fmt.Errorf("Updating unknown record failed: %s", err)
+	}
+
+	_, err = objMgr.UpdateUnknownRecord(d.Id(), recordUnknown)
+	if err != nil {
+		return fmt.Errorf("Updating unknown record failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of unknown record complete", resourceUnknownRecordIDString(d))
+	return resourceUnknownRecordGet(d, m)
+}
+
+func resourceUnknownRecordDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of unknown record", resourceUnknownRecordIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteUnknownRecord(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of unknown record failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of unknown record complete", resourceUnknownRecordIDString(d))
+	return nil
+}
+
+// resourceUnknownRecordImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID. A name with several unknown records
+// can only be imported by WAPI reference.
+func resourceUnknownRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "record:unknown") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewRecordUnknown(ibclient.RecordUnknown{View: dnsView, Name: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceUnknownRecordIDStringInterface interface {
+	Id() string
+}
+
+func resourceUnknownRecordIDString(d resourceUnknownRecordIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_unknown_record (ID = %s)", id)
+}
@@ -0,0 +1,222 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceUnknownRecord(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUnknownRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceUnknownRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccUnknownRecordExists(t, "infoblox_unknown_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "name", "host1"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "record_type", "SSHFP"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "subfield_values.#", "3"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "subfield_values.0.field_value", "4"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "dns_view", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceUnknownRecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccUnknownRecordExists(t, "infoblox_unknown_record.foo"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "subfield_values.1.field_value", "1"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "subfield_values.2.field_value", "123456789ABCDEF67890123456789ABCDEF67890"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "sshfp.0.algorithm", "4"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.foo", "ext_attrs.Site", "HQ"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_unknown_record.foo",
+				ImportState:             true,
+				ImportStateId:           "default/host1.a.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sshfp"},
+			},
+		},
+	})
+}
+
+func TestUnknownRecordSSHFPValidation(t *testing.T) {
+	sshfp := func(algorithm int, fingerprintType int, fingerprint string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"algorithm":        algorithm,
+			"fingerprint_type": fingerprintType,
+			"fingerprint":      fingerprint,
+		}}
+	}
+	sha1 := "123456789ABCDEF67890123456789ABCDEF67890"
+	sha256 := "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+
+	cases := []struct {
+		raw map[string]interface{}
+		err *regexp.Regexp
+	}{
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(3, 2, sha256)}, nil},
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(1, 1, sha1)}, nil},
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(5, 1, sha1)}, regexp.MustCompile("must be one of 1, 2, 3, 4 or 6")},
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(1, 3, sha1)}, regexp.MustCompile("must be between 1 and 2")},
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(1, 1, "SHA1")}, regexp.MustCompile("must be hex encoded")},
+		{map[string]interface{}{"record_type": "SSHFP", "sshfp": sshfp(1, 2, sha1)}, regexp.MustCompile("must be 64 hex digits long, got 40")},
+		{map[string]interface{}{"record_type": "TXT", "sshfp": sshfp(1, 1, sha1)}, regexp.MustCompile("only be set for SSHFP records")},
+		{map[string]interface{}{
+			"record_type":     "SSHFP",
+			"sshfp":           sshfp(1, 1, sha1),
+			"subfield_values": []interface{}{map[string]interface{}{"field_type": "T", "field_value": "a"}},
+		}, regexp.MustCompile("conflicts with")},
+	}
+
+	r := resourceUnknownRecord()
+	for _, tc := range cases {
+		tc.raw["zone"] = "a.com"
+		tc.raw["tenant_id"] = "foo"
+		c := terraform.NewResourceConfigRaw(tc.raw)
+		var err error
+		if _, errs := r.Validate(c); len(errs) > 0 {
+			err = errs[0]
+		} else {
+			_, err = r.Diff(nil, c, nil)
+		}
+		if tc.err == nil && err != nil || tc.err != nil && (err == nil || !tc.err.MatchString(err.Error())) {
+			t.Fatalf("validating %v returned error %v, expected %v", tc.raw, err, tc.err)
+		}
+	}
+}
+
+func TestUnknownRecordSSHFPMapping(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUnknownRecord().Schema, map[string]interface{}{
+		"name":        "host1",
+		"zone":        "a.com",
+		"record_type": "SSHFP",
+		"sshfp": []interface{}{map[string]interface{}{
+			"algorithm":        4,
+			"fingerprint_type": 1,
+			"fingerprint":      "123456789abcdef67890123456789abcdef67890",
+		}},
+		"tenant_id": "foo",
+	})
+	connector, requestor := testConnector(
+		`"record:unknown/ZG5z:host1.a.com/default"`,
+		`{"_ref": "record:unknown/ZG5z:host1.a.com/default", "name": "host1.a.com", "zone": "a.com",
+		  "view": "default", "record_type": "SSHFP", "subfield_values": [
+		    {"field_type": "B", "field_value": "4", "include_length": "NONE"},
+		    {"field_type": "B", "field_value": "1", "include_length": "NONE"},
+		    {"field_type": "X", "field_value": "123456789ABCDEF67890123456789ABCDEF67890", "include_length": "NONE"}]}`,
+	)
+
+	if err := resourceUnknownRecordCreate(d, connector); err != nil {
+		t.Fatalf("resourceUnknownRecordCreate returned error %v", err)
+	}
+
+	obj := requestor.requests[0].object(t)
+	if obj["name"] != "host1.a.com" || obj["record_type"] != "SSHFP" {
+		t.Fatalf("create request sent %v", obj)
+	}
+	subfields := obj["subfield_values"].([]interface{})
+	expected := [][2]string{{"B", "4"}, {"B", "1"}, {"X", "123456789abcdef67890123456789abcdef67890"}}
+	if len(subfields) != len(expected) {
+		t.Fatalf("create request sent subfield_values %v", subfields)
+	}
+	for i, field := range expected {
+		subfield := subfields[i].(map[string]interface{})
+		if subfield["field_type"] != field[0] || subfield["field_value"] != field[1] {
+			t.Fatalf("create request sent subfield %d %v, expected %v", i, subfield, field)
+		}
+	}
+
+	if d.Get("sshfp.0.algorithm") != 4 || d.Get("sshfp.0.fingerprint_type") != 1 ||
+		d.Get("sshfp.0.fingerprint") != "123456789ABCDEF67890123456789ABCDEF67890" {
+		t.Fatalf("state has sshfp %v", d.Get("sshfp"))
+	}
+	if d.Get("subfield_values.#") != 3 || d.Get("subfield_values.2.field_type") != "X" {
+		t.Fatalf("state has subfield_values %v", d.Get("subfield_values"))
+	}
+}
+
+func testAccCheckUnknownRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_unknown_record" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetUnknownRecordByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("unknown record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccUnknownRecordExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetUnknownRecordByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unknown record not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceUnknownRecordCreate = fmt.Sprintf(`
+resource "infoblox_unknown_record" "foo"{
+	name="host1"
+	zone="a.com"
+	record_type="SSHFP"
+	subfield_values {
+		field_type="B"
+		field_value="4"
+	}
+	subfield_values {
+		field_type="B"
+		field_value="2"
+	}
+	subfield_values {
+		field_type="X"
+		field_value="0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceUnknownRecordUpdate = fmt.Sprintf(`
+resource "infoblox_unknown_record" "foo"{
+	name="host1"
+	zone="a.com"
+	record_type="SSHFP"
+	sshfp {
+		algorithm=4
+		fingerprint_type=1
+		fingerprint="123456789abcdef67890123456789abcdef67890"
+	}
+	ext_attrs = {
+		"Site" = "HQ"
+	}
+	tenant_id="foo"
+	}`)
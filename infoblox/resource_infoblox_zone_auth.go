@@ -0,0 +1,393 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+// soaFields are the SOA timer arguments of infoblox_zone_auth. Setting any
+// of them overrides the zone timers inherited from the grid, the timers left
+// unset then keep the values of NIOS. Removing all of them reverts the zone
+// to the grid timers.
+var soaFields = []string{"soa_default_ttl", "soa_expire", "soa_negative_ttl", "soa_refresh", "soa_retry"}
+
+func resourceZoneAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneAuthCreate,
+		Read:   resourceZoneAuthRead,
+		Update: resourceZoneAuthUpdate,
+		Delete: resourceZoneAuthDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceZoneAuthImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone. Reverse zones are given in cidr format, e.g. 10.0.0.0/24.",
+			},
+			"zone_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FORWARD",
+				ForceNew:     true,
+				ValidateFunc: validateZoneFormat,
+				Description:  "The format of the zone: FORWARD, IPV4 or IPV6.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone is created.",
+			},
+			"grid_primary": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          memberServerResource(),
+				ConflictsWith: []string{"ns_group"},
+				Description:   "Grid members serving the zone as primary.",
+			},
+			"grid_secondaries": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          memberServerResource(),
+				ConflictsWith: []string{"ns_group"},
+				Description:   "Grid members serving the zone as secondaries.",
+			},
+			"ns_group": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"grid_primary", "grid_secondaries"},
+				Description:   "The name server group serving the zone.",
+			},
+			"soa_default_ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The default TTL of the zone records in seconds.",
+			},
+			"soa_expire": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The time in seconds after which secondaries stop answering for the zone when the primary is unreachable.",
+			},
+			"soa_negative_ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The time in seconds negative answers are cached.",
+			},
+			"soa_refresh": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The interval in seconds at which secondaries check the zone for updates.",
+			},
+			"soa_retry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The interval in seconds at which secondaries retry a failed refresh.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the zone.",
+			},
+			"restart_if_needed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restart the DNS service of the members serving the zone when the change requires it.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the zone.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func memberServerResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the grid member.",
+			},
+			"stealth": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the member is hidden from the NS records of the zone.",
+			},
+		},
+	}
+}
+
+func validateZoneFormat(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "FORWARD", "IPV4", "IPV6":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of FORWARD, IPV4 or IPV6, got %q", k, v.(string)))
+	}
+	return
+}
+
+// memberServers converts a grid_primary or grid_secondaries list to member
+// servers.
+func memberServers(members []interface{}) []ibclient.MemberServer {
+	servers := []ibclient.MemberServer{}
+	for _, v := range members {
+		member := v.(map[string]interface{})
+		stealth := member["stealth"].(bool)
+		servers = append(servers, ibclient.MemberServer{
+			Name:    member["name"].(string),
+			Stealth: &stealth,
+		})
+	}
+	return servers
+}
+
+// memberServersForState converts member servers returned by NIOS to a
+// grid_primary or grid_secondaries list.
+func memberServersForState(servers *[]ibclient.MemberServer) []interface{} {
+	members := make([]interface{}, 0)
+	if servers == nil {
+		return members
+	}
+	for _, server := range *servers {
+		members = append(members, map[string]interface{}{
+			"name":    server.Name,
+			"stealth": server.Stealth != nil && *server.Stealth,
+		})
+	}
+	return members
+}
+
+// buildZoneAuth returns the settings of the zone which can be changed after
+// creation.
+func buildZoneAuth(d *schema.ResourceData) ibclient.ZoneAuth {
+	gridPrimary := memberServers(d.Get("grid_primary").([]interface{}))
+	gridSecondaries := memberServers(d.Get("grid_secondaries").([]interface{}))
+	nsGroup := d.Get("ns_group").(string)
+	comment := d.Get("comment").(string)
+	restartIfNeeded := d.Get("restart_if_needed").(bool)
+
+	zoneAuth := ibclient.ZoneAuth{
+		GridPrimary:     &gridPrimary,
+		GridSecondaries: &gridSecondaries,
+		Comment:         &comment,
+		RestartIfNeeded: &restartIfNeeded,
+		Ea:              eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+	if nsGroup != "" || d.HasChange("ns_group") {
+		zoneAuth.NsGroup = &nsGroup
+	}
+
+	values := make(map[string]*uint)
+	useGridZoneTimer := false
+	for _, key := range soaFields {
+		if v, ok := d.GetOk(key); ok {
+			value := uint(v.(int))
+			values[key] = &value
+			useGridZoneTimer = true
+		}
+	}
+	zoneAuth.UseGridZoneTimer = &useGridZoneTimer
+	if useGridZoneTimer {
+		zoneAuth.SoaDefaultTtl = values["soa_default_ttl"]
+		zoneAuth.SoaExpire = values["soa_expire"]
+		zoneAuth.SoaNegativeTtl = values["soa_negative_ttl"]
+		zoneAuth.SoaRefresh = values["soa_refresh"]
+		zoneAuth.SoaRetry = values["soa_retry"]
+	}
+
+	return zoneAuth
+}
+
+func resourceZoneAuthCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create zone", resourceZoneAuthIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	za := buildZoneAuth(d)
+	za.Fqdn = fqdn
+	za.View = dnsView
+	za.ZoneFormat = d.Get("zone_format").(string)
+
+	zoneAuth, err := objMgr.CreateZoneAuth(za)
+	if err != nil {
+		return fmt.Errorf("Creation of zone (%s) failed in dns view (%s) : %s", fqdn, dnsView, err)
+	}
+	d.SetId(zoneAuth.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of zone complete", resourceZoneAuthIDString(d))
+	return resourceZoneAuthRead(d, m)
+}
+
+func resourceZoneAuthRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required zone", resourceZoneAuthIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetZoneAuthByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: zone not found, removing it from state", resourceZoneAuthIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting zone failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Fqdn)
+	d.Set("zone_format", obj.ZoneFormat)
+	d.Set("dns_view", obj.View)
+	if err := d.Set("grid_primary", memberServersForState(obj.GridPrimary)); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", memberServersForState(obj.GridSecondaries)); err != nil {
+		return err
+	}
+	if obj.NsGroup != nil {
+		d.Set("ns_group", *obj.NsGroup)
+	} else {
+		d.Set("ns_group", "")
+	}
+	for key, value := range soaTimersForState(d, obj) {
+		d.Set(key, value)
+	}
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading zone", resourceZoneAuthIDString(d))
+	return nil
+}
+
+// soaTimersForState returns the SOA timers of the zone to keep in state.
+// They are only kept when they override the grid, and then only the timers
+// already in state, as NIOS returns all of them once one is set. When no
+// timer is in state, as after an import, all of them are kept.
+func soaTimersForState(d *schema.ResourceData, obj *ibclient.ZoneAuth) map[string]int {
+	values := map[string]*uint{
+		"soa_default_ttl":  obj.SoaDefaultTtl,
+		"soa_expire":       obj.SoaExpire,
+		"soa_negative_ttl": obj.SoaNegativeTtl,
+		"soa_refresh":      obj.SoaRefresh,
+		"soa_retry":        obj.SoaRetry,
+	}
+	useGridZoneTimer := obj.UseGridZoneTimer != nil && *obj.UseGridZoneTimer
+
+	inState := false
+	for _, key := range soaFields {
+		if d.Get(key).(int) != 0 {
+			inState = true
+		}
+	}
+
+	timers := make(map[string]int)
+	for _, key := range soaFields {
+		timers[key] = 0
+		if useGridZoneTimer && values[key] != nil && (!inState || d.Get(key).(int) != 0) {
+			timers[key] = int(*values[key])
+		}
+	}
+	return timers
+}
+
+func resourceZoneAuthUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of zone", resourceZoneAuthIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateZoneAuth(d.Id(), buildZoneAuth(d))
+	if err != nil {
+		return fmt.Errorf("Update of zone failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of zone complete", resourceZoneAuthIDString(d))
+	return resourceZoneAuthRead(d, m)
+}
+
+func resourceZoneAuthDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of zone", resourceZoneAuthIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteZoneAuth(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of zone failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of zone complete", resourceZoneAuthIDString(d))
+	return nil
+}
+
+// resourceZoneAuthImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID, where fqdn is in cidr format for
+// reverse zones.
+func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "zone_auth") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewZoneAuth(ibclient.ZoneAuth{View: dnsView, Fqdn: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+	d.Set("restart_if_needed", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceZoneAuthIDStringInterface interface {
+	Id() string
+}
+
+func resourceZoneAuthIDString(d resourceZoneAuthIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_zone_auth (ID = %s)", id)
+}
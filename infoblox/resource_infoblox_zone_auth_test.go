@@ -0,0 +1,242 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceZoneAuth(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceZoneAuthCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthExists(t, "infoblox_zone_auth.forward"),
+					testAccZoneAuthExists(t, "infoblox_zone_auth.reverse"),
+					testAccZoneAuthExists(t, "infoblox_zone_auth.reverse6"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.forward", "fqdn", "tf-acc.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.forward", "grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.reverse", "zone_format", "IPV4"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.reverse6", "zone_format", "IPV6"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceZoneAuthUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthExists(t, "infoblox_zone_auth.forward"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.forward", "comment", "updated in place"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.forward", "soa_refresh", "7200"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "infoblox_zone_auth.forward",
+				ImportState:             true,
+				ImportStateId:           "default/tf-acc.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_if_needed", "soa_default_ttl", "soa_expire", "soa_negative_ttl", "soa_retry"},
+			},
+			resource.TestStep{
+				Config: testAccresourceZoneAuthCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthExists(t, "infoblox_zone_auth.forward"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.forward", "soa_refresh", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateZoneFormat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "IPV4",
+			f:   validateZoneFormat,
+		},
+		{
+			val:         "REVERSE",
+			f:           validateZoneFormat,
+			expectedErr: regexp.MustCompile("must be one of FORWARD, IPV4 or IPV6"),
+		},
+	})
+}
+
+func TestBuildZoneAuthSoaTimers(t *testing.T) {
+	attributes := map[string]string{
+		"fqdn":              "a.com",
+		"zone_format":       "FORWARD",
+		"dns_view":          "default",
+		"tenant_id":         "foo",
+		"restart_if_needed": "false",
+		"soa_refresh":       "600",
+		"soa_retry":         "60",
+	}
+
+	cases := []struct {
+		raw              map[string]interface{}
+		useGridZoneTimer bool
+		soaRefresh       uint
+	}{
+		{map[string]interface{}{"fqdn": "a.com", "tenant_id": "foo"}, false, 0},
+		{map[string]interface{}{"fqdn": "a.com", "tenant_id": "foo", "soa_refresh": 900}, true, 900},
+	}
+
+	for _, tc := range cases {
+		za := buildZoneAuth(testResourceDataUpdate(t, resourceZoneAuth(), attributes, tc.raw))
+		if za.UseGridZoneTimer == nil || *za.UseGridZoneTimer != tc.useGridZoneTimer {
+			t.Fatalf("buildZoneAuth(%v) returned use_grid_zone_timer %v, expected %t", tc.raw, za.UseGridZoneTimer, tc.useGridZoneTimer)
+		}
+		if tc.soaRefresh == 0 && za.SoaRefresh != nil || tc.soaRefresh != 0 && (za.SoaRefresh == nil || *za.SoaRefresh != tc.soaRefresh) {
+			t.Fatalf("buildZoneAuth(%v) returned soa_refresh %v, expected %d", tc.raw, za.SoaRefresh, tc.soaRefresh)
+		}
+		if za.SoaRetry != nil {
+			t.Fatalf("buildZoneAuth(%v) returned soa_retry %d, expected none", tc.raw, *za.SoaRetry)
+		}
+	}
+}
+
+func TestSoaTimersForState(t *testing.T) {
+	uintPtr := func(v uint) *uint { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	obj := &ibclient.ZoneAuth{
+		UseGridZoneTimer: boolPtr(true),
+		SoaDefaultTtl:    uintPtr(3600),
+		SoaExpire:        uintPtr(2419200),
+		SoaNegativeTtl:   uintPtr(900),
+		SoaRefresh:       uintPtr(600),
+		SoaRetry:         uintPtr(3600),
+	}
+
+	cases := []struct {
+		raw      map[string]interface{}
+		obj      *ibclient.ZoneAuth
+		expected map[string]int
+	}{
+		{
+			map[string]interface{}{"soa_refresh": 300},
+			obj,
+			map[string]int{"soa_default_ttl": 0, "soa_expire": 0, "soa_negative_ttl": 0, "soa_refresh": 600, "soa_retry": 0},
+		},
+		{
+			map[string]interface{}{},
+			obj,
+			map[string]int{"soa_default_ttl": 3600, "soa_expire": 2419200, "soa_negative_ttl": 900, "soa_refresh": 600, "soa_retry": 3600},
+		},
+		{
+			map[string]interface{}{"soa_refresh": 300},
+			&ibclient.ZoneAuth{UseGridZoneTimer: boolPtr(false), SoaRefresh: uintPtr(10800)},
+			map[string]int{"soa_default_ttl": 0, "soa_expire": 0, "soa_negative_ttl": 0, "soa_refresh": 0, "soa_retry": 0},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceZoneAuth().Schema, tc.raw)
+		timers := soaTimersForState(d, tc.obj)
+		for key, value := range tc.expected {
+			if timers[key] != value {
+				t.Fatalf("soaTimersForState(%v) returned %s %d, expected %d", tc.raw, key, timers[key], value)
+			}
+		}
+	}
+}
+
+func testAccCheckZoneAuthDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_auth" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetZoneAuthByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneAuthExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetZoneAuthByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("zone not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceZoneAuthCreate = fmt.Sprintf(`
+resource "infoblox_zone_auth" "forward"{
+	fqdn="tf-acc.com"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	tenant_id="foo"
+	}
+resource "infoblox_zone_auth" "reverse"{
+	fqdn="10.200.0.0/24"
+	zone_format="IPV4"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	tenant_id="foo"
+	}
+resource "infoblox_zone_auth" "reverse6"{
+	fqdn="2001:db8:200::/64"
+	zone_format="IPV6"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceZoneAuthUpdate = fmt.Sprintf(`
+resource "infoblox_zone_auth" "forward"{
+	fqdn="tf-acc.com"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	soa_refresh=7200
+	comment="updated in place"
+	restart_if_needed=true
+	tenant_id="foo"
+	}
+resource "infoblox_zone_auth" "reverse"{
+	fqdn="10.200.0.0/24"
+	zone_format="IPV4"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	tenant_id="foo"
+	}
+resource "infoblox_zone_auth" "reverse6"{
+	fqdn="2001:db8:200::/64"
+	zone_format="IPV6"
+	grid_primary {
+		name="infoblox.localdomain"
+	}
+	tenant_id="foo"
+	}`)
@@ -0,0 +1,254 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceZoneDelegated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneDelegatedCreate,
+		Read:   resourceZoneDelegatedRead,
+		Update: resourceZoneDelegatedUpdate,
+		Delete: resourceZoneDelegatedDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceZoneDelegatedImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the delegated zone. Reverse zones are given in cidr format, e.g. 10.0.0.0/24.",
+			},
+			"zone_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FORWARD",
+				ForceNew:     true,
+				ValidateFunc: validateZoneFormat,
+				Description:  "The format of the zone: FORWARD, IPV4 or IPV6.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone is created.",
+			},
+			"delegate_to": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        nameServerResource(),
+				Description: "The name servers the zone is delegated to.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the zone.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the zone.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func nameServerResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the name server.",
+			},
+			"address": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address of the name server.",
+			},
+		},
+	}
+}
+
+// nameServers converts a delegate_to list to name servers.
+func nameServers(servers []interface{}) []ibclient.NameServer {
+	res := []ibclient.NameServer{}
+	for _, v := range servers {
+		server := v.(map[string]interface{})
+		res = append(res, ibclient.NameServer{
+			Name:    server["name"].(string),
+			Address: server["address"].(string),
+		})
+	}
+	return res
+}
+
+// nameServersForState converts name servers returned by NIOS to a
+// delegate_to list.
+func nameServersForState(servers []ibclient.NameServer) []interface{} {
+	res := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		res = append(res, map[string]interface{}{
+			"name":    server.Name,
+			"address": server.Address,
+		})
+	}
+	return res
+}
+
+// buildZoneDelegated returns the settings of the zone which can be changed
+// after creation.
+func buildZoneDelegated(d *schema.ResourceData) ibclient.ZoneDelegated {
+	comment := d.Get("comment").(string)
+
+	return ibclient.ZoneDelegated{
+		DelegateTo: nameServers(d.Get("delegate_to").([]interface{})),
+		Comment:    &comment,
+		Ea:         eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create delegated zone", resourceZoneDelegatedIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	zd := buildZoneDelegated(d)
+	zd.Fqdn = fqdn
+	zd.View = dnsView
+	zd.ZoneFormat = d.Get("zone_format").(string)
+
+	zoneDelegated, err := objMgr.CreateZoneDelegated(zd)
+	if err != nil {
+		return fmt.Errorf("Creation of delegated zone (%s) failed in dns view (%s) : %s", fqdn, dnsView, err)
+	}
+	d.SetId(zoneDelegated.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of delegated zone complete", resourceZoneDelegatedIDString(d))
+	return resourceZoneDelegatedRead(d, m)
+}
+
+func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required delegated zone", resourceZoneDelegatedIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetZoneDelegatedByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: delegated zone not found, removing it from state", resourceZoneDelegatedIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting delegated zone failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Fqdn)
+	d.Set("zone_format", obj.ZoneFormat)
+	d.Set("dns_view", obj.View)
+	if err := d.Set("delegate_to", nameServersForState(obj.DelegateTo)); err != nil {
+		return err
+	}
+	if obj.Comment != nil {
+		d.Set("comment", *obj.Comment)
+	} else {
+		d.Set("comment", "")
+	}
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading delegated zone", resourceZoneDelegatedIDString(d))
+	return nil
+}
+
+func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of delegated zone", resourceZoneDelegatedIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateZoneDelegated(d.Id(), buildZoneDelegated(d))
+	if err != nil {
+		return fmt.Errorf("Update of delegated zone failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of delegated zone complete", resourceZoneDelegatedIDString(d))
+	return resourceZoneDelegatedRead(d, m)
+}
+
+func resourceZoneDelegatedDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of delegated zone", resourceZoneDelegatedIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteZoneDelegated(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of delegated zone failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of delegated zone complete", resourceZoneDelegatedIDString(d))
+	return nil
+}
+
+// resourceZoneDelegatedImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceZoneDelegatedImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "zone_delegated") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewZoneDelegated(ibclient.ZoneDelegated{View: dnsView, Fqdn: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceZoneDelegatedIDStringInterface interface {
+	Id() string
+}
+
+func resourceZoneDelegatedIDString(d resourceZoneDelegatedIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_zone_delegated (ID = %s)", id)
+}
@@ -0,0 +1,150 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceZoneDelegated(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDelegatedDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceZoneDelegatedCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneDelegatedExists(t, "infoblox_zone_delegated.foo"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "fqdn", "sub.a.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "dns_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "delegate_to.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "delegate_to.0.name", "ns1.sub.a.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "delegate_to.0.address", "10.0.0.53"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceZoneDelegatedUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneDelegatedExists(t, "infoblox_zone_delegated.foo"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "delegate_to.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "delegate_to.1.name", "ns2.sub.a.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_delegated.foo", "comment", "delegated to the cloud team"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_zone_delegated.foo",
+				ImportState:       true,
+				ImportStateId:     "default/sub.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestZoneDelegatedMapping(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceZoneDelegated().Schema, map[string]interface{}{
+		"fqdn":        "10.0.0.0/24",
+		"zone_format": "IPV4",
+		"delegate_to": []interface{}{
+			map[string]interface{}{"name": "ns1.b.com", "address": "10.1.0.53"},
+		},
+		"tenant_id": "foo",
+	})
+	connector, requestor := testConnector(
+		`"zone_delegated/ZG5z:0.0.10.in-addr.arpa/default"`,
+		`{"_ref": "zone_delegated/ZG5z:0.0.10.in-addr.arpa/default", "fqdn": "10.0.0.0/24",
+		  "view": "default", "zone_format": "IPV4", "comment": "moved",
+		  "delegate_to": [{"name": "ns2.b.com", "address": "10.2.0.53"}],
+		  "extattrs": {"Tenant ID": {"value": "foo"}}}`,
+	)
+
+	if err := resourceZoneDelegatedCreate(d, connector); err != nil {
+		t.Fatalf("resourceZoneDelegatedCreate returned error %v", err)
+	}
+
+	obj := requestor.requests[0].object(t)
+	if obj["fqdn"] != "10.0.0.0/24" || obj["zone_format"] != "IPV4" || obj["view"] != "default" {
+		t.Fatalf("create request sent %v", obj)
+	}
+	delegateTo := obj["delegate_to"].([]interface{})
+	if len(delegateTo) != 1 || delegateTo[0].(map[string]interface{})["name"] != "ns1.b.com" || delegateTo[0].(map[string]interface{})["address"] != "10.1.0.53" {
+		t.Fatalf("create request sent delegate_to %v", delegateTo)
+	}
+
+	if d.Get("delegate_to.#") != 1 || d.Get("delegate_to.0.name") != "ns2.b.com" || d.Get("delegate_to.0.address") != "10.2.0.53" {
+		t.Fatalf("state has delegate_to %v", d.Get("delegate_to"))
+	}
+	if d.Get("comment") != "moved" || len(d.Get("ext_attrs").(map[string]interface{})) != 0 {
+		t.Fatalf("state has comment %v and ext_attrs %v", d.Get("comment"), d.Get("ext_attrs"))
+	}
+}
+
+func testAccCheckZoneDelegatedDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_delegated" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetZoneDelegatedByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("delegated zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneDelegatedExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetZoneDelegatedByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("delegated zone not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceZoneDelegatedCreate = fmt.Sprintf(`
+resource "infoblox_zone_delegated" "foo"{
+	fqdn="sub.a.com"
+	delegate_to {
+		name="ns1.sub.a.com"
+		address="10.0.0.53"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceZoneDelegatedUpdate = fmt.Sprintf(`
+resource "infoblox_zone_delegated" "foo"{
+	fqdn="sub.a.com"
+	delegate_to {
+		name="ns1.sub.a.com"
+		address="10.0.0.53"
+	}
+	delegate_to {
+		name="ns2.sub.a.com"
+		address="10.0.1.53"
+	}
+	comment="delegated to the cloud team"
+	tenant_id="foo"
+	}`)
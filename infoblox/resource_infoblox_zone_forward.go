@@ -0,0 +1,294 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func resourceZoneForward() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneForwardCreate,
+		Read:   resourceZoneForwardRead,
+		Update: resourceZoneForwardUpdate,
+		Delete: resourceZoneForwardDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceZoneForwardImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the forward zone. Reverse zones are given in cidr format, e.g. 10.0.0.0/24.",
+			},
+			"zone_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FORWARD",
+				ForceNew:     true,
+				ValidateFunc: validateZoneFormat,
+				Description:  "The format of the zone: FORWARD, IPV4 or IPV6.",
+			},
+			"dns_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Dns View under which the zone is created.",
+			},
+			"forward_to": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        nameServerResource(),
+				Description: "The name servers the queries for the zone are forwarded to.",
+			},
+			"forwarding_servers": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        forwardingMemberServerResource(),
+				Description: "Grid members forwarding the queries for the zone.",
+			},
+			"forwarders_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only send queries for the zone to the forwarders, without falling back to recursion.",
+			},
+			"comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A descriptive comment for the zone.",
+			},
+			"ext_attrs": &schema.Schema{
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateExtAttrs,
+				Description:  "Extensible attributes of the zone.",
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of your tenant in cloud.",
+			},
+		},
+	}
+}
+
+func forwardingMemberServerResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the grid member.",
+			},
+			"forwarders_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only send queries for the zone to the forwarders, without falling back to recursion.",
+			},
+			"forward_to": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        nameServerResource(),
+				Description: "The name servers the member forwards to instead of the forwarders of the zone.",
+			},
+		},
+	}
+}
+
+// forwardingMemberServers converts a forwarding_servers list to forwarding
+// member servers.
+func forwardingMemberServers(members []interface{}) []ibclient.ForwardingMemberServer {
+	servers := []ibclient.ForwardingMemberServer{}
+	for _, v := range members {
+		member := v.(map[string]interface{})
+		forwardersOnly := member["forwarders_only"].(bool)
+		server := ibclient.ForwardingMemberServer{
+			Name:           member["name"].(string),
+			ForwardersOnly: &forwardersOnly,
+		}
+		forwardTo := nameServers(member["forward_to"].([]interface{}))
+		useOverrideForwarders := len(forwardTo) > 0
+		server.UseOverrideForwarders = &useOverrideForwarders
+		if useOverrideForwarders {
+			server.ForwardTo = &forwardTo
+		}
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+// forwardingMemberServersForState converts forwarding member servers
+// returned by NIOS to a forwarding_servers list.
+func forwardingMemberServersForState(servers *[]ibclient.ForwardingMemberServer) []interface{} {
+	members := make([]interface{}, 0)
+	if servers == nil {
+		return members
+	}
+	for _, server := range *servers {
+		forwardTo := make([]interface{}, 0)
+		if boolValue(server.UseOverrideForwarders) && server.ForwardTo != nil {
+			forwardTo = nameServersForState(*server.ForwardTo)
+		}
+		members = append(members, map[string]interface{}{
+			"name":            server.Name,
+			"forwarders_only": boolValue(server.ForwardersOnly),
+			"forward_to":      forwardTo,
+		})
+	}
+	return members
+}
+
+// buildZoneForward returns the settings of the zone which can be changed
+// after creation.
+func buildZoneForward(d *schema.ResourceData) ibclient.ZoneForward {
+	forwardingServers := forwardingMemberServers(d.Get("forwarding_servers").([]interface{}))
+	forwardersOnly := d.Get("forwarders_only").(bool)
+	comment := d.Get("comment").(string)
+
+	return ibclient.ZoneForward{
+		ForwardTo:         nameServers(d.Get("forward_to").([]interface{})),
+		ForwardingServers: &forwardingServers,
+		ForwardersOnly:    &forwardersOnly,
+		Comment:           &comment,
+		Ea:                eaFromExtAttrs(d.Get("ext_attrs")),
+	}
+}
+
+func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning to create forward zone", resourceZoneForwardIDString(d))
+
+	fqdn := d.Get("fqdn").(string)
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	zf := buildZoneForward(d)
+	zf.Fqdn = fqdn
+	zf.View = dnsView
+	zf.ZoneFormat = d.Get("zone_format").(string)
+
+	zoneForward, err := objMgr.CreateZoneForward(zf)
+	if err != nil {
+		return fmt.Errorf("Creation of forward zone (%s) failed in dns view (%s) : %s", fqdn, dnsView, err)
+	}
+	d.SetId(zoneForward.Ref)
+
+	log.Printf("[DEBUG] %s: Creation of forward zone complete", resourceZoneForwardIDString(d))
+	return resourceZoneForwardRead(d, m)
+}
+
+func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Reading the required forward zone", resourceZoneForwardIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	obj, err := objMgr.GetZoneForwardByRef(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s: forward zone not found, removing it from state", resourceZoneForwardIDString(d))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting forward zone failed from dns view (%s) : %s", dnsView, err)
+	}
+	d.Set("fqdn", obj.Fqdn)
+	d.Set("zone_format", obj.ZoneFormat)
+	d.Set("dns_view", obj.View)
+	if err := d.Set("forward_to", nameServersForState(obj.ForwardTo)); err != nil {
+		return err
+	}
+	if err := d.Set("forwarding_servers", forwardingMemberServersForState(obj.ForwardingServers)); err != nil {
+		return err
+	}
+	d.Set("forwarders_only", boolValue(obj.ForwardersOnly))
+	d.Set("comment", stringValue(obj.Comment))
+	d.Set("ext_attrs", extAttrsFromEA(obj.Ea))
+	setTenantID(d, obj.Ea)
+
+	d.SetId(obj.Ref)
+	log.Printf("[DEBUG] %s: Completed reading forward zone", resourceZoneForwardIDString(d))
+	return nil
+}
+
+func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning update of forward zone", resourceZoneForwardIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.UpdateZoneForward(d.Id(), buildZoneForward(d))
+	if err != nil {
+		return fmt.Errorf("Update of forward zone failed in dns view (%s) : %s", dnsView, err)
+	}
+
+	log.Printf("[DEBUG] %s: Update of forward zone complete", resourceZoneForwardIDString(d))
+	return resourceZoneForwardRead(d, m)
+}
+
+func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Deletion of forward zone", resourceZoneForwardIDString(d))
+
+	dnsView := d.Get("dns_view").(string)
+	tenantID := d.Get("tenant_id").(string)
+	connector := m.(*ibclient.Connector)
+
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	_, err := objMgr.DeleteZoneForward(d.Id())
+	if err != nil {
+		return fmt.Errorf("Deletion of forward zone failed from dns view(%s) : %s", dnsView, err)
+	}
+	d.SetId("")
+
+	log.Printf("[DEBUG] %s: Deletion of forward zone complete", resourceZoneForwardIDString(d))
+	return nil
+}
+
+// resourceZoneForwardImport accepts either a WAPI reference or
+// <dns_view>/<fqdn> as the import ID.
+func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(*ibclient.Connector)
+
+	if !isWapiRef(d.Id(), "zone_forward") {
+		dnsView, fqdn, err := splitImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		ref, err := searchObjectRef(connector, ibclient.NewZoneForward(ibclient.ZoneForward{View: dnsView, Fqdn: fqdn}), d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(ref)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type resourceZoneForwardIDStringInterface interface {
+	Id() string
+}
+
+func resourceZoneForwardIDString(d resourceZoneForwardIDStringInterface) string {
+	id := d.Id()
+	if id == "" {
+		id = "<new resource>"
+	}
+	return fmt.Sprintf("infoblox_zone_forward (ID = %s)", id)
+}
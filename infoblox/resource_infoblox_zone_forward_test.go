@@ -0,0 +1,174 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestAccResourceZoneForward(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneForwardDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceZoneForwardCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneForwardExists(t, "infoblox_zone_forward.foo"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "fqdn", "aws.a.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "dns_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forward_to.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forward_to.0.address", "10.1.0.2"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forwarders_only", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccresourceZoneForwardUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneForwardExists(t, "infoblox_zone_forward.foo"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forward_to.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forward_to.1.address", "10.1.1.2"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "forwarders_only", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_forward.foo", "comment", "Route 53 resolver"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "infoblox_zone_forward.foo",
+				ImportState:       true,
+				ImportStateId:     "default/aws.a.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestZoneForwardMapping(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceZoneForward().Schema, map[string]interface{}{
+		"fqdn": "corp.a.com",
+		"forward_to": []interface{}{
+			map[string]interface{}{"name": "dns1.corp.a.com", "address": "10.1.0.53"},
+		},
+		"forwarding_servers": []interface{}{
+			map[string]interface{}{"name": "member1.a.com"},
+			map[string]interface{}{
+				"name":            "member2.a.com",
+				"forwarders_only": true,
+				"forward_to": []interface{}{
+					map[string]interface{}{"name": "dns2.corp.a.com", "address": "10.2.0.53"},
+				},
+			},
+		},
+		"forwarders_only": true,
+		"tenant_id":       "foo",
+	})
+	connector, requestor := testConnector(
+		`"zone_forward/ZG5z:corp.a.com/default"`,
+		`{"_ref": "zone_forward/ZG5z:corp.a.com/default", "fqdn": "corp.a.com", "view": "default",
+		  "zone_format": "FORWARD", "forwarders_only": false,
+		  "forward_to": [{"name": "dns1.corp.a.com", "address": "10.1.0.53"}],
+		  "forwarding_servers": [
+		    {"name": "member1.a.com", "forwarders_only": false, "use_override_forwarders": false},
+		    {"name": "member2.a.com", "forwarders_only": true, "use_override_forwarders": true,
+		     "forward_to": [{"name": "dns3.corp.a.com", "address": "10.3.0.53"}]}],
+		  "extattrs": {"Tenant ID": {"value": "foo"}}}`,
+	)
+
+	if err := resourceZoneForwardCreate(d, connector); err != nil {
+		t.Fatalf("resourceZoneForwardCreate returned error %v", err)
+	}
+
+	obj := requestor.requests[0].object(t)
+	if obj["fqdn"] != "corp.a.com" || obj["zone_format"] != "FORWARD" || obj["forwarders_only"] != true {
+		t.Fatalf("create request sent %v", obj)
+	}
+	servers := obj["forwarding_servers"].([]interface{})
+	if len(servers) != 2 {
+		t.Fatalf("create request sent forwarding_servers %v", servers)
+	}
+	member1 := servers[0].(map[string]interface{})
+	if member1["use_override_forwarders"] != false || member1["forward_to"] != nil {
+		t.Fatalf("create request sent forwarding server %v, expected no override", member1)
+	}
+	member2 := servers[1].(map[string]interface{})
+	if member2["use_override_forwarders"] != true || member2["forwarders_only"] != true || len(member2["forward_to"].([]interface{})) != 1 {
+		t.Fatalf("create request sent forwarding server %v, expected an override", member2)
+	}
+
+	if d.Get("forwarders_only") != false || d.Get("forwarding_servers.#") != 2 {
+		t.Fatalf("state has forwarders_only %v and forwarding_servers %v", d.Get("forwarders_only"), d.Get("forwarding_servers"))
+	}
+	if d.Get("forwarding_servers.0.forward_to.#") != 0 || d.Get("forwarding_servers.1.forward_to.0.name") != "dns3.corp.a.com" {
+		t.Fatalf("state has forwarding_servers %v", d.Get("forwarding_servers"))
+	}
+}
+
+func testAccCheckZoneForwardDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_forward" {
+			continue
+		}
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+		_, err := objMgr.GetZoneForwardByRef(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("forward zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneForwardExists(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found:%s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID i set")
+		}
+		meta := testAccProvider.Meta()
+		Connector := meta.(*ibclient.Connector)
+		objMgr := ibclient.NewObjectManager(Connector, "terraform_test", "test")
+
+		_, err := objMgr.GetZoneForwardByRef(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("forward zone not found: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testAccresourceZoneForwardCreate = fmt.Sprintf(`
+resource "infoblox_zone_forward" "foo"{
+	fqdn="aws.a.com"
+	forward_to {
+		name="resolver1.aws.a.com"
+		address="10.1.0.2"
+	}
+	tenant_id="foo"
+	}`)
+
+var testAccresourceZoneForwardUpdate = fmt.Sprintf(`
+resource "infoblox_zone_forward" "foo"{
+	fqdn="aws.a.com"
+	forward_to {
+		name="resolver1.aws.a.com"
+		address="10.1.0.2"
+	}
+	forward_to {
+		name="resolver2.aws.a.com"
+		address="10.1.1.2"
+	}
+	forwarders_only=true
+	comment="Route 53 resolver"
+	tenant_id="foo"
+	}`)
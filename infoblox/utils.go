@@ -0,0 +1,536 @@
+package infoblox
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+// reservedEAs are the extensible attributes set by the provider itself,
+// either by ibclient.ObjectManager or from dedicated arguments such as
+// tenant_id, vm_name, vm_id and network_name. They are kept out of ext_attrs.
+var reservedEAs = map[string]bool{
+	"Cloud API Owned": true,
+	"CMP Type":        true,
+	"Tenant ID":       true,
+	"VM ID":           true,
+	"VM Name":         true,
+	"Network Name":    true,
+}
+
+// validateExtAttrs rejects extensible attributes which are managed through
+// dedicated arguments.
+func validateExtAttrs(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if reservedEAs[key] {
+			errors = append(errors, fmt.Errorf("%q: extensible attribute %q is managed by the provider and cannot be set in %s", k, key, k))
+		}
+	}
+	return
+}
+
+// validateIntBetween returns a validate function checking that an integer
+// argument is between min and max, both included.
+func validateIntBetween(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if value := v.(int); value < min || value > max {
+			errors = append(errors, fmt.Errorf("%q must be between %d and %d, got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
+// validateUint16 checks that an integer argument such as an MX preference
+// or an SRV port fits in an unsigned 16 bit field.
+var validateUint16 = validateIntBetween(0, 65535)
+
+// eaFromExtAttrs converts the ext_attrs argument to extensible attributes.
+func eaFromExtAttrs(extAttrs interface{}) ibclient.EA {
+	ea := make(ibclient.EA)
+	for key, value := range extAttrs.(map[string]interface{}) {
+		ea[key] = value
+	}
+	return ea
+}
+
+// extAttrsFromEA returns the extensible attributes of an object without
+// the attributes reserved for the provider.
+func extAttrsFromEA(ea ibclient.EA) map[string]string {
+	extAttrs := make(map[string]string)
+	for key := range ea {
+		if !reservedEAs[key] {
+			extAttrs[key] = getEAValue(ea, key)
+		}
+	}
+	return extAttrs
+}
+
+// extAttrsChange returns the extensible attributes to set and to remove
+// for the ext_attrs change recorded in d.
+func extAttrsChange(d *schema.ResourceData) (ibclient.EA, ibclient.EA) {
+	oldExtAttrs, newExtAttrs := d.GetChange("ext_attrs")
+	addEA := eaFromExtAttrs(newExtAttrs)
+	removeEA := make(ibclient.EA)
+	for key := range oldExtAttrs.(map[string]interface{}) {
+		if _, ok := addEA[key]; !ok {
+			removeEA[key] = ""
+		}
+	}
+	return addEA, removeEA
+}
+
+// vmExtAttrsChange returns the extensible attributes to add and to remove
+// for the changes of the ext_attrs, vm_id, vm_name and tenant_id arguments.
+func vmExtAttrsChange(d *schema.ResourceData) (ibclient.EA, ibclient.EA) {
+	addEA, removeEA := extAttrsChange(d)
+	for key, name := range map[string]string{"vm_id": "VM ID", "vm_name": "VM Name"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		if value := d.Get(key).(string); value != "" {
+			addEA[name] = value
+		} else {
+			removeEA[name] = ""
+		}
+	}
+	if d.HasChange("tenant_id") {
+		addEA["Tenant ID"] = d.Get("tenant_id").(string)
+	}
+	return addEA, removeEA
+}
+
+// getEAValue returns the value of the extensible attribute as a string,
+// or an empty string when the object does not carry the attribute.
+func getEAValue(ea ibclient.EA, key string) string {
+	value, ok := ea[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// uintValue returns the value of an optional unsigned integer field of a
+// NIOS object, or 0 when the object does not carry the field.
+func uintValue(v *uint) uint {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// stringValue returns the value of an optional string field of a NIOS
+// object, or an empty string when the object does not carry the field.
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// boolValue returns the value of an optional boolean field of a NIOS
+// object, or false when the object does not carry the field.
+func boolValue(v *bool) bool {
+	return v != nil && *v
+}
+
+// recordTTL returns the ttl and use_ttl fields for the ttl argument of a
+// DNS record. A ttl of 0 leaves the record on the default TTL of its zone.
+func recordTTL(d *schema.ResourceData) (*uint, *bool) {
+	ttl, useTTL := d.GetOk("ttl")
+	ttlValue := uint(ttl.(int))
+	return &ttlValue, &useTTL
+}
+
+// ttlForState is the inverse of recordTTL. It returns 0 for a record on the
+// default TTL of its zone.
+func ttlForState(ttl *uint, useTTL *bool) int {
+	if !boolValue(useTTL) {
+		return 0
+	}
+	return int(uintValue(ttl))
+}
+
+// specialDhcpOptions are the DHCP options which NIOS inherits from the
+// parent object unless use_option is set, by name and code.
+var specialDhcpOptions = map[string]uint{
+	"routers":             3,
+	"domain-name-servers": 6,
+	"domain-name":         15,
+	"broadcast-address":   28,
+	"dhcp-lease-time":     51,
+}
+
+func dhcpOptionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the option, e.g. routers or domain-name-servers.",
+			},
+			"num": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntBetween(1, 254),
+				Description:  "The code of the option. Either name or num must be set.",
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the option, lists are comma separated.",
+			},
+			"vendor_class": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "DHCP",
+				Description: "The option space of the option.",
+			},
+			"use_option": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Use the value instead of the one inherited from the parent object. Only applies to routers, domain-name-servers, domain-name, broadcast-address and dhcp-lease-time.",
+			},
+		},
+	}
+}
+
+// isSpecialDhcpOption reports whether the option given by name or num has
+// a use_option flag in NIOS.
+func isSpecialDhcpOption(name string, num uint, vendorClass string) bool {
+	if vendorClass != "DHCP" {
+		return false
+	}
+	for specialName, specialNum := range specialDhcpOptions {
+		if name == specialName || num == specialNum {
+			return true
+		}
+	}
+	return false
+}
+
+// dhcpOptions converts a dhcp_option list to DHCP options.
+func dhcpOptions(options []interface{}) []ibclient.DhcpOption {
+	res := []ibclient.DhcpOption{}
+	for _, v := range options {
+		option := v.(map[string]interface{})
+		dhcpOption := ibclient.DhcpOption{
+			Name:        option["name"].(string),
+			Num:         uint(option["num"].(int)),
+			Value:       option["value"].(string),
+			VendorClass: option["vendor_class"].(string),
+		}
+		if isSpecialDhcpOption(dhcpOption.Name, dhcpOption.Num, dhcpOption.VendorClass) {
+			useOption := option["use_option"].(bool)
+			dhcpOption.UseOption = &useOption
+		}
+		res = append(res, dhcpOption)
+	}
+	return res
+}
+
+// dhcpOptionMatches reports whether the DHCP option returned by NIOS is the
+// one configured in the dhcp_option list entry, which gives either its name
+// or its code.
+func dhcpOptionMatches(configured map[string]interface{}, option ibclient.DhcpOption) bool {
+	if configured["vendor_class"].(string) != option.VendorClass {
+		return false
+	}
+	name := configured["name"].(string)
+	num := configured["num"].(int)
+	return (name != "" && name == option.Name) || (num != 0 && uint(num) == option.Num)
+}
+
+// dhcpOptionsForState converts DHCP options returned by NIOS to a
+// dhcp_option list. The configured options come first and in the configured
+// order, so that a diff only shows actual changes. Options which are not in
+// use are inherited, such as the lease time NIOS reports for every object,
+// and are only kept when configured.
+func dhcpOptionsForState(configured []interface{}, options *[]ibclient.DhcpOption) []interface{} {
+	res := make([]interface{}, 0)
+	if options == nil {
+		return res
+	}
+	remaining := append([]ibclient.DhcpOption{}, *options...)
+	for _, v := range configured {
+		for i, option := range remaining {
+			if dhcpOptionMatches(v.(map[string]interface{}), option) {
+				res = append(res, dhcpOptionForState(option))
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	for _, option := range remaining {
+		if option.UseOption == nil || *option.UseOption {
+			res = append(res, dhcpOptionForState(option))
+		}
+	}
+	return res
+}
+
+func dhcpOptionForState(option ibclient.DhcpOption) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         option.Name,
+		"num":          int(option.Num),
+		"value":        option.Value,
+		"vendor_class": option.VendorClass,
+		"use_option":   option.UseOption == nil || *option.UseOption,
+	}
+}
+
+// isNotFoundError reports whether err means that the object no longer
+// exists in NIOS.
+func isNotFoundError(err error) bool {
+	_, ok := err.(*ibclient.NotFoundError)
+	return ok
+}
+
+// setTenantID refreshes tenant_id from the "Tenant ID" extensible attribute
+// when the object carries one.
+func setTenantID(d *schema.ResourceData, ea ibclient.EA) {
+	if tenantID := getEAValue(ea, "Tenant ID"); tenantID != "" {
+		d.Set("tenant_id", tenantID)
+	}
+}
+
+// splitRecordName splits a record FQDN into the record name and the zone.
+// When the FQDN does not end with the given zone, the first label is used
+// as the record name and the remainder as the zone.
+func splitRecordName(fqdn string, zone string) (string, string) {
+	if zone != "" && strings.HasSuffix(fqdn, "."+zone) {
+		return strings.TrimSuffix(fqdn, "."+zone), zone
+	}
+	parts := strings.SplitN(fqdn, ".", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// recordFQDN returns the FQDN of a record given by its name relative to the
+// zone. An empty name stands for the zone apex.
+func recordFQDN(name string, zone string) string {
+	if name == "" {
+		return zone
+	}
+	return name + "." + zone
+}
+
+// relativeRecordName is the inverse of recordFQDN.
+func relativeRecordName(fqdn string, zone string) string {
+	if fqdn == zone {
+		return ""
+	}
+	return strings.TrimSuffix(fqdn, "."+zone)
+}
+
+// checkRecordName checks that a record is named either by fqdn or by both
+// nameKey and zone.
+func checkRecordName(fqdn string, name string, zone string, nameKey string) error {
+	if name == "" && zone == "" {
+		if fqdn == "" {
+			return fmt.Errorf("either fqdn or %s and zone must be set", nameKey)
+		}
+		return nil
+	}
+	if name == "" || zone == "" {
+		return fmt.Errorf("%s and zone must be set together", nameKey)
+	}
+	return nil
+}
+
+// recordNameCustomizeDiff rejects records named neither by fqdn nor by
+// nameKey and zone at plan time. Values which are not known until apply are
+// checked by the create and update functions instead.
+func recordNameCustomizeDiff(nameKey string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		for _, key := range []string{"fqdn", nameKey, "zone"} {
+			if !d.NewValueKnown(key) {
+				return nil
+			}
+		}
+		return checkRecordName(d.Get("fqdn").(string), d.Get(nameKey).(string), d.Get("zone").(string), nameKey)
+	}
+}
+
+// vmRecordFQDN returns the FQDN of an A or PTR record, given either by the
+// fqdn argument or by vm_name and zone.
+func vmRecordFQDN(d *schema.ResourceData) (string, error) {
+	fqdn := d.Get("fqdn").(string)
+	vmName := d.Get("vm_name").(string)
+	zone := d.Get("zone").(string)
+	if err := checkRecordName(fqdn, vmName, zone, "vm_name"); err != nil {
+		return "", err
+	}
+	if vmName == "" {
+		return fqdn, nil
+	}
+	return vmName + "." + zone, nil
+}
+
+// usesFQDNArg reports whether the record in d is configured by fqdn rather
+// than by nameKey and zone. Imported records start out with neither and
+// are given nameKey and zone.
+func usesFQDNArg(d *schema.ResourceData, nameKey string) bool {
+	return d.Get(nameKey).(string) == "" && d.Get("fqdn").(string) != ""
+}
+
+// validateHexData checks that a string argument holds hex encoded data.
+func validateHexData(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := hex.DecodeString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be hex encoded: %s", k, err))
+	}
+	return
+}
+
+// suppressCaseDiff ignores differences in letter case, e.g. in hex data
+// which NIOS returns in upper case.
+func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// normalizeMacAddr converts a MAC address to the colon separated, lower case
+// format returned by NIOS.
+func normalizeMacAddr(mac string) string {
+	return strings.ToLower(strings.Replace(mac, "-", ":", -1))
+}
+
+// suppressMacAddrDiff ignores differences in MAC address notation.
+func suppressMacAddrDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMacAddr(old) == normalizeMacAddr(new)
+}
+
+// suppressDynamicIPDiff ignores the address allocated by NIOS when ip_addr
+// is left empty in the configuration for a next available IP allocation.
+func suppressDynamicIPDiff(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" && old != ""
+}
+
+// macAddrForState returns the MAC address to store in state. The zero MAC
+// address used for allocations without a MAC is kept out of the state when
+// no MAC address was configured.
+func macAddrForState(d *schema.ResourceData, mac string) string {
+	if mac == ibclient.MACADDR_ZERO && d.Get("mac_addr").(string) == "" {
+		return ""
+	}
+	return mac
+}
+
+// validateMatchClient checks how DHCP clients are matched to a fixed
+// address.
+func validateMatchClient(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "MAC_ADDRESS", "CLIENT_ID", "RESERVED", "CIRCUIT_ID", "REMOTE_ID":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of MAC_ADDRESS, CLIENT_ID, RESERVED, CIRCUIT_ID or REMOTE_ID, got %q", k, v.(string)))
+	}
+	return
+}
+
+// matchClientIdentifiers are the arguments identifying the DHCP client of a
+// fixed address, by match_client.
+var matchClientIdentifiers = map[string]string{
+	"CLIENT_ID":  "dhcp_client_identifier",
+	"CIRCUIT_ID": "agent_circuit_id",
+	"REMOTE_ID":  "agent_remote_id",
+}
+
+// matchClientIdentifier returns the identifier the DHCP client of the fixed
+// address in d is matched by when match_client is CLIENT_ID, CIRCUIT_ID or
+// REMOTE_ID: the DHCP client identifier, the circuit ID or the remote ID. It
+// is empty for MAC_ADDRESS and RESERVED, the MAC address is set on its own.
+func matchClientIdentifier(d *schema.ResourceData) (string, error) {
+	matchClient := d.Get("match_client").(string)
+	key, ok := matchClientIdentifiers[matchClient]
+	if !ok {
+		return "", nil
+	}
+	identifier := d.Get(key).(string)
+	if identifier == "" {
+		return "", fmt.Errorf("%s is required when match_client is %s", key, matchClient)
+	}
+	return identifier, nil
+}
+
+// ipAddrForCreate returns the address to send when creating an A, AAAA or
+// PTR record: ip_addr when set, otherwise the next available IP of cidr in
+// the network view netview.
+func ipAddrForCreate(d *schema.ResourceData, netview string) string {
+	ipAddr := d.Get("ip_addr").(string)
+	if ipAddr != "" {
+		return ipAddr
+	}
+	return fmt.Sprintf("func:nextavailableip:%s,%s", d.Get("cidr").(string), netview)
+}
+
+// ipAddrForUpdate returns the address to send when updating an A, AAAA or
+// PTR record, or an empty string when the address is unchanged. A changed
+// ip_addr is sent as is. When only cidr changes and the current address is
+// outside of the new network, the next available IP of cidr in the network
+// view netview is allocated.
+func ipAddrForUpdate(d *schema.ResourceData, netview string) string {
+	ipAddr := d.Get("ip_addr").(string)
+	if d.HasChange("ip_addr") && ipAddr != "" {
+		return ipAddr
+	}
+	cidr := d.Get("cidr").(string)
+	if d.HasChange("cidr") && cidr != "" && !ipAddrInCidr(ipAddr, cidr) {
+		return fmt.Sprintf("func:nextavailableip:%s,%s", cidr, netview)
+	}
+	return ""
+}
+
+// ipAddrInCidr reports whether ipAddr belongs to the network cidr.
+func ipAddrInCidr(ipAddr string, cidr string) bool {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	return network.Contains(net.ParseIP(ipAddr))
+}
+
+// isWapiRef reports whether id is a WAPI reference to an object of objType,
+// such as "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQ:test.a.com/default".
+func isWapiRef(id string, objType string) bool {
+	return strings.HasPrefix(id, objType+"/") && strings.Contains(id, ":")
+}
+
+// isIPAddress reports whether s is a literal IPv4 or IPv6 address.
+func isIPAddress(s string) bool {
+	return net.ParseIP(s) != nil
+}
+
+// splitImportID splits an import ID given as a natural key of the form
+// "<view>/<key>" into the view and the key.
+func splitImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid import ID (%s): expected a WAPI reference or <view>/<key>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// searchObjectRef returns the reference of the single object matching the
+// search object. key is only used to describe the search in errors.
+func searchObjectRef(connector *ibclient.Connector, obj ibclient.IBObject, key string) (string, error) {
+	var res []struct {
+		Ref string `json:"_ref"`
+	}
+	if err := connector.GetObject(obj, "", &res); err != nil {
+		return "", fmt.Errorf("Search of %s (%s) failed: %s", obj.ObjectType(), key, err)
+	}
+	if len(res) == 0 {
+		return "", fmt.Errorf("No %s found for %s", obj.ObjectType(), key)
+	}
+	if len(res) > 1 {
+		return "", fmt.Errorf("Found %d %s objects for %s, import by WAPI reference instead", len(res), obj.ObjectType(), key)
+	}
+	return res[0].Ref, nil
+}
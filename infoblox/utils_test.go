@@ -0,0 +1,363 @@
+package infoblox
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client"
+)
+
+func TestSplitRecordName(t *testing.T) {
+	cases := []struct {
+		fqdn         string
+		zone         string
+		expectedName string
+		expectedZone string
+	}{
+		{"test-name.a.com", "a.com", "test-name", "a.com"},
+		{"test.name.a.com", "a.com", "test.name", "a.com"},
+		{"test-name.a.com", "", "test-name", "a.com"},
+		{"test-name.a.com", "b.com", "test-name", "a.com"},
+		{"localhost", "", "localhost", ""},
+	}
+
+	for _, tc := range cases {
+		name, zone := splitRecordName(tc.fqdn, tc.zone)
+		if name != tc.expectedName || zone != tc.expectedZone {
+			t.Fatalf("splitRecordName(%q, %q) returned (%q, %q), expected (%q, %q)",
+				tc.fqdn, tc.zone, name, zone, tc.expectedName, tc.expectedZone)
+		}
+	}
+}
+
+func TestRecordFQDN(t *testing.T) {
+	cases := []struct {
+		name string
+		zone string
+		fqdn string
+	}{
+		{"www", "a.com", "www.a.com"},
+		{"_443._tcp.www", "a.com", "_443._tcp.www.a.com"},
+		{"", "a.com", "a.com"},
+	}
+
+	for _, tc := range cases {
+		if fqdn := recordFQDN(tc.name, tc.zone); fqdn != tc.fqdn {
+			t.Fatalf("recordFQDN(%q, %q) returned %q, expected %q", tc.name, tc.zone, fqdn, tc.fqdn)
+		}
+		if name := relativeRecordName(tc.fqdn, tc.zone); name != tc.name {
+			t.Fatalf("relativeRecordName(%q, %q) returned %q, expected %q", tc.fqdn, tc.zone, name, tc.name)
+		}
+	}
+}
+
+func TestVMRecordFQDN(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected string
+		err      bool
+	}{
+		{map[string]interface{}{"vm_name": "web", "zone": "a.com"}, "web.a.com", false},
+		{map[string]interface{}{"fqdn": "a.com"}, "a.com", false},
+		{map[string]interface{}{"vm_name": "web"}, "", true},
+		{map[string]interface{}{}, "", true},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceARecord().Schema, tc.raw)
+		res, err := vmRecordFQDN(d)
+		if (err != nil) != tc.err {
+			t.Fatalf("vmRecordFQDN(%v) returned error %v", tc.raw, err)
+		}
+		if res != tc.expected {
+			t.Fatalf("vmRecordFQDN(%v) returned %q, expected %q", tc.raw, res, tc.expected)
+		}
+	}
+}
+
+func TestRecordNameCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		resource *schema.Resource
+		raw      map[string]interface{}
+		err      bool
+	}{
+		{resourceARecord(), map[string]interface{}{"vm_name": "web", "zone": "a.com", "ip_addr": "10.0.0.1", "tenant_id": "foo"}, false},
+		{resourceARecord(), map[string]interface{}{"fqdn": "a.com", "ip_addr": "10.0.0.1", "tenant_id": "foo"}, false},
+		{resourceARecord(), map[string]interface{}{"ip_addr": "10.0.0.1", "tenant_id": "foo"}, true},
+		{resourceARecord(), map[string]interface{}{"fqdn": hcl2shim.UnknownVariableValue, "ip_addr": "10.0.0.1", "tenant_id": "foo"}, false},
+		{resourceARecord(), map[string]interface{}{"vm_name": "web", "ip_addr": "10.0.0.1", "tenant_id": "foo"}, true},
+		{resourceARecord(), map[string]interface{}{"vm_name": "web", "zone": hcl2shim.UnknownVariableValue, "ip_addr": "10.0.0.1", "tenant_id": "foo"}, false},
+		{resourcePTRRecord(), map[string]interface{}{"ip_addr": "10.0.0.1", "tenant_id": "foo"}, true},
+		{resourceCNAMERecord(), map[string]interface{}{"alias": "www", "zone": "a.com", "canonical": "a.com", "tenant_id": "foo"}, false},
+		{resourceCNAMERecord(), map[string]interface{}{"canonical": "a.com", "tenant_id": "foo"}, true},
+	}
+
+	for _, tc := range cases {
+		_, err := tc.resource.Diff(nil, terraform.NewResourceConfigRaw(tc.raw), nil)
+		if (err != nil) != tc.err {
+			t.Fatalf("Diff(%v) returned error %v", tc.raw, err)
+		}
+	}
+}
+
+func TestMatchClientIdentifier(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected string
+		err      bool
+	}{
+		{map[string]interface{}{"mac_addr": "AA-BB-CC-DD-EE-FF"}, "", false},
+		{map[string]interface{}{}, "", false},
+		{map[string]interface{}{"match_client": "CLIENT_ID", "dhcp_client_identifier": "01:aa"}, "01:aa", false},
+		{map[string]interface{}{"match_client": "CLIENT_ID", "agent_remote_id": "r1"}, "", true},
+		{map[string]interface{}{"match_client": "CIRCUIT_ID", "agent_circuit_id": "c1"}, "c1", false},
+		{map[string]interface{}{"match_client": "REMOTE_ID", "agent_remote_id": "r1"}, "r1", false},
+		{map[string]interface{}{"match_client": "RESERVED", "mac_addr": "aa:bb:cc:dd:ee:ff"}, "", false},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceIPAllocation().Schema, tc.raw)
+		res, err := matchClientIdentifier(d)
+		if (err != nil) != tc.err {
+			t.Fatalf("matchClientIdentifier(%v) returned error %v", tc.raw, err)
+		}
+		if res != tc.expected {
+			t.Fatalf("matchClientIdentifier(%v) returned %q, expected %q", tc.raw, res, tc.expected)
+		}
+	}
+}
+
+func TestNormalizeMacAddr(t *testing.T) {
+	cases := map[string]string{
+		"AA-BB-CC-DD-EE-FF": "aa:bb:cc:dd:ee:ff",
+		"aa:bb:cc:dd:ee:ff": "aa:bb:cc:dd:ee:ff",
+		"":                  "",
+	}
+
+	for mac, expected := range cases {
+		if normalized := normalizeMacAddr(mac); normalized != expected {
+			t.Fatalf("normalizeMacAddr(%q) returned %q, expected %q", mac, normalized, expected)
+		}
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	if !isNotFoundError(ibclient.NewNotFoundError("not found")) {
+		t.Fatal("expected NotFoundError to be detected as not found")
+	}
+	if isNotFoundError(errors.New("WAPI request error: 400")) {
+		t.Fatal("expected generic error not to be detected as not found")
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	view, key, err := splitImportID("default/10.0.0.0/24")
+	if err != nil || view != "default" || key != "10.0.0.0/24" {
+		t.Fatalf("splitImportID returned (%q, %q, %v)", view, key, err)
+	}
+
+	for _, id := range []string{"", "default", "default/", "/10.0.0.0/24"} {
+		if _, _, err := splitImportID(id); err == nil {
+			t.Fatalf("expected splitImportID(%q) to fail", id)
+		}
+	}
+}
+
+func TestIsWapiRef(t *testing.T) {
+	ref := "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"
+	if !isWapiRef(ref, "network") {
+		t.Fatalf("expected %q to be a network reference", ref)
+	}
+	if isWapiRef(ref, "record:a") {
+		t.Fatalf("expected %q not to be an A record reference", ref)
+	}
+	if isWapiRef("default/10.0.0.0/24", "network") {
+		t.Fatal("expected natural key not to be a network reference")
+	}
+}
+
+func TestIPAddrInCidr(t *testing.T) {
+	cases := []struct {
+		ipAddr   string
+		cidr     string
+		expected bool
+	}{
+		{"10.0.0.5", "10.0.0.0/24", true},
+		{"10.0.1.5", "10.0.0.0/24", false},
+		{"2001:db8::2", "2001:db8::/64", true},
+		{"", "10.0.0.0/24", false},
+		{"10.0.0.5", "", false},
+	}
+
+	for _, tc := range cases {
+		if res := ipAddrInCidr(tc.ipAddr, tc.cidr); res != tc.expected {
+			t.Fatalf("ipAddrInCidr(%q, %q) returned %v, expected %v", tc.ipAddr, tc.cidr, res, tc.expected)
+		}
+	}
+}
+
+func TestTTLForState(t *testing.T) {
+	ttl := uint(300)
+	useTTL := true
+	noTTL := false
+	cases := []struct {
+		ttl      *uint
+		useTTL   *bool
+		expected int
+	}{
+		{&ttl, &useTTL, 300},
+		{&ttl, &noTTL, 0},
+		{&ttl, nil, 0},
+		{nil, &useTTL, 0},
+	}
+
+	for _, tc := range cases {
+		if res := ttlForState(tc.ttl, tc.useTTL); res != tc.expected {
+			t.Fatalf("ttlForState returned %d, expected %d", res, tc.expected)
+		}
+	}
+}
+
+func TestDHCPOptions(t *testing.T) {
+	options := dhcpOptions([]interface{}{
+		map[string]interface{}{"name": "routers", "num": 0, "value": "10.0.0.1", "vendor_class": "DHCP", "use_option": false},
+		map[string]interface{}{"name": "", "num": 67, "value": "pxelinux.0", "vendor_class": "DHCP", "use_option": true},
+	})
+	if len(options) != 2 || options[0].UseOption == nil || *options[0].UseOption {
+		t.Fatalf("expected use_option to be sent for routers, got %+v", options)
+	}
+	if options[1].UseOption != nil || options[1].Num != 67 {
+		t.Fatalf("expected use_option not to be sent for option 67, got %+v", options[1])
+	}
+}
+
+func TestDHCPOptionsForState(t *testing.T) {
+	used := true
+	unused := false
+	options := []ibclient.DhcpOption{
+		{Name: "dhcp-lease-time", Num: 51, Value: "43200", VendorClass: "DHCP", UseOption: &unused},
+		{Name: "domain-name", Num: 15, Value: "a.com", VendorClass: "DHCP", UseOption: &used},
+		{Name: "routers", Num: 3, Value: "10.0.0.1", VendorClass: "DHCP", UseOption: &unused},
+		{Name: "bootfile-name", Num: 67, Value: "pxelinux.0", VendorClass: "DHCP"},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"name": "", "num": 67, "vendor_class": "DHCP"},
+		map[string]interface{}{"name": "routers", "num": 0, "vendor_class": "DHCP"},
+	}
+
+	res := dhcpOptionsForState(configured, &options)
+	expected := []string{"bootfile-name", "routers", "domain-name"}
+	if len(res) != len(expected) {
+		t.Fatalf("dhcpOptionsForState returned %v, expected options %v", res, expected)
+	}
+	for i, name := range expected {
+		if option := res[i].(map[string]interface{}); option["name"] != name {
+			t.Fatalf("dhcpOptionsForState returned %v, expected options %v", res, expected)
+		}
+	}
+	if res[1].(map[string]interface{})["use_option"] != false {
+		t.Fatalf("expected routers not to be in use, got %v", res[1])
+	}
+}
+
+func TestValidateExtAttrs(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: map[string]interface{}{"Site": "HQ", "Owner": "netops"},
+			f:   validateExtAttrs,
+		},
+		{
+			val:         map[string]interface{}{"Tenant ID": "foo"},
+			f:           validateExtAttrs,
+			expectedErr: regexp.MustCompile("managed by the provider"),
+		},
+	})
+}
+
+func TestValidateHexData(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0c72AC70",
+			f:   validateHexData,
+		},
+		{
+			val:         "0c72ac7",
+			f:           validateHexData,
+			expectedErr: regexp.MustCompile("must be hex encoded"),
+		},
+	})
+}
+
+func TestExtAttrsFromEA(t *testing.T) {
+	ea := ibclient.EA{
+		"Site":            "HQ",
+		"Floor":           3,
+		"Tenant ID":       "foo",
+		"CMP Type":        "Terraform",
+		"Cloud API Owned": ibclient.Bool(true),
+	}
+	extAttrs := extAttrsFromEA(ea)
+	if len(extAttrs) != 2 || extAttrs["Site"] != "HQ" || extAttrs["Floor"] != "3" {
+		t.Fatalf("unexpected ext_attrs %v", extAttrs)
+	}
+}
+
+// testResourceDataUpdate returns the resource data of an update of the
+// resource r from the state attributes to the configuration raw.
+func testResourceDataUpdate(t *testing.T, r *schema.Resource, attributes map[string]string, raw map[string]interface{}) *schema.ResourceData {
+	state := &terraform.InstanceState{ID: "fixedaddress/ZG5z:10.0.0.1/default", Attributes: attributes}
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("Diff(%v) returned error %v", raw, err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("Data(%v) returned error %v", raw, err)
+	}
+	return d
+}
+
+func TestVMExtAttrsChange(t *testing.T) {
+	attributes := map[string]string{
+		"cidr":            "10.0.0.0/24",
+		"vm_name":         "web",
+		"vm_id":           "42",
+		"tenant_id":       "foo",
+		"ext_attrs.%":     "2",
+		"ext_attrs.Site":  "HQ",
+		"ext_attrs.Floor": "3",
+	}
+
+	// Removing every extensible attribute.
+	d := testResourceDataUpdate(t, resourceIPAllocation(), attributes, map[string]interface{}{
+		"cidr":      "10.0.0.0/24",
+		"vm_name":   "web",
+		"tenant_id": "foo",
+	})
+	addEA, removeEA := vmExtAttrsChange(d)
+	if len(addEA) != 0 {
+		t.Fatalf("vmExtAttrsChange returned attributes to add %v", addEA)
+	}
+	if len(removeEA) != 3 || removeEA["Site"] != "" || removeEA["Floor"] != "" || removeEA["VM ID"] != "" {
+		t.Fatalf("vmExtAttrsChange returned attributes to remove %v, expected Site, Floor and VM ID", removeEA)
+	}
+
+	d = testResourceDataUpdate(t, resourceIPAssociation(), attributes, map[string]interface{}{
+		"cidr":      "10.0.0.0/24",
+		"vm_name":   "db",
+		"vm_id":     "42",
+		"tenant_id": "bar",
+		"ext_attrs": map[string]interface{}{"Site": "DC"},
+	})
+	addEA, removeEA = vmExtAttrsChange(d)
+	if len(addEA) != 3 || addEA["Site"] != "DC" || addEA["VM Name"] != "db" || addEA["Tenant ID"] != "bar" {
+		t.Fatalf("vmExtAttrsChange returned attributes to add %v", addEA)
+	}
+	if len(removeEA) != 1 || removeEA["Floor"] != "" {
+		t.Fatalf("vmExtAttrsChange returned attributes to remove %v, expected Floor", removeEA)
+	}
+}